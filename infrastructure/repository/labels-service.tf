@@ -5,7 +5,6 @@ variable "service_labels" {
     "account",
     "acm",
     "acmpca",
-    "alexaforbusiness",
     "amp",
     "amplify",
     "amplifybackend",
@@ -84,6 +83,7 @@ variable "service_labels" {
     "dlm",
     "dms",
     "docdb",
+    "docdbelastic",
     "drs",
     "ds",
     "dynamodb",
@@ -129,7 +129,6 @@ variable "service_labels" {
     "guardduty",
     "health",
     "healthlake",
-    "honeycode",
     "iam",
     "identitystore",
     "imagebuilder",
@@ -178,7 +177,6 @@ variable "service_labels" {
     "lookoutmetrics",
     "lookoutvision",
     "machinelearning",
-    "macie",
     "macie2",
     "managedblockchain",
     "marketplacecatalog",
@@ -200,7 +198,6 @@ variable "service_labels" {
     "migrationhubconfig",
     "migrationhubrefactorspaces",
     "migrationhubstrategy",
-    "mobile",
     "mq",
     "mturk",
     "mwaa",
@@ -240,6 +237,7 @@ variable "service_labels" {
     "robomaker",
     "route53",
     "route53domains",
+    "route53profiles",
     "route53recoverycluster",
     "route53recoverycontrolconfig",
     "route53recoveryreadiness",
@@ -285,6 +283,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -292,6 +291,7 @@ variable "service_labels" {
     "transfer",
     "transitgateway",
     "translate",
+    "verifiedaccess",
     "voiceid",
     "vpc",
     "vpnclient",
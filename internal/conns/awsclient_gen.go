@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/aws/aws-sdk-go/service/alexaforbusiness"
 	"github.com/aws/aws-sdk-go/service/amplify"
 	"github.com/aws/aws-sdk-go/service/amplifybackend"
 	"github.com/aws/aws-sdk-go/service/amplifyuibuilder"
@@ -94,6 +93,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/directoryservice"
 	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
 	"github.com/aws/aws-sdk-go/service/drs"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
@@ -135,7 +135,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/guardduty"
 	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/aws/aws-sdk-go/service/healthlake"
-	"github.com/aws/aws-sdk-go/service/honeycode"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/identitystore"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -182,7 +181,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/managedgrafana"
@@ -204,7 +202,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/migrationhubconfig"
 	"github.com/aws/aws-sdk-go/service/migrationhubrefactorspaces"
 	"github.com/aws/aws-sdk-go/service/migrationhubstrategyrecommendations"
-	"github.com/aws/aws-sdk-go/service/mobile"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/aws/aws-sdk-go/service/mturk"
 	"github.com/aws/aws-sdk-go/service/mwaa"
@@ -244,6 +241,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/robomaker"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
 	"github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
@@ -286,6 +284,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/swf"
 	"github.com/aws/aws-sdk-go/service/synthetics"
 	"github.com/aws/aws-sdk-go/service/textract"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
 	"github.com/aws/aws-sdk-go/service/timestreamquery"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go/service/transcribeservice"
@@ -317,6 +316,7 @@ type AWSClient struct {
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
+	S3ExpressControlConn      *s3.S3
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
@@ -329,7 +329,6 @@ type AWSClient struct {
 	APIGatewayV2Conn                 *apigatewayv2.ApiGatewayV2
 	AccessAnalyzerConn               *accessanalyzer.AccessAnalyzer
 	AccountConn                      *account.Account
-	AlexaForBusinessConn             *alexaforbusiness.AlexaForBusiness
 	AmplifyConn                      *amplify.Amplify
 	AmplifyBackendConn               *amplifybackend.AmplifyBackend
 	AmplifyUIBuilderConn             *amplifyuibuilder.AmplifyUIBuilder
@@ -406,6 +405,7 @@ type AWSClient struct {
 	DirectConnectConn                *directconnect.DirectConnect
 	DiscoveryConn                    *applicationdiscoveryservice.ApplicationDiscoveryService
 	DocDBConn                        *docdb.DocDB
+	DocDBElasticConn                 *docdbelastic.DocDBElastic
 	DynamoDBConn                     *dynamodb.DynamoDB
 	DynamoDBStreamsConn              *dynamodbstreams.DynamoDBStreams
 	EBSConn                          *ebs.EBS
@@ -447,7 +447,6 @@ type AWSClient struct {
 	GuardDutyConn                    *guardduty.GuardDuty
 	HealthConn                       *health.Health
 	HealthLakeConn                   *healthlake.HealthLake
-	HoneycodeConn                    *honeycode.Honeycode
 	IAMConn                          *iam.IAM
 	IVSConn                          *ivs.IVS
 	IdentityStoreConn                *identitystore.IdentityStore
@@ -498,7 +497,6 @@ type AWSClient struct {
 	MTurkConn                        *mturk.MTurk
 	MWAAConn                         *mwaa.MWAA
 	MachineLearningConn              *machinelearning.MachineLearning
-	MacieConn                        *macie.Macie
 	Macie2Conn                       *macie2.Macie2
 	ManagedBlockchainConn            *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn           *marketplacecatalog.MarketplaceCatalog
@@ -519,7 +517,6 @@ type AWSClient struct {
 	MigrationHubConfigConn           *migrationhubconfig.MigrationHubConfig
 	MigrationHubRefactorSpacesConn   *migrationhubrefactorspaces.MigrationHubRefactorSpaces
 	MigrationHubStrategyConn         *migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations
-	MobileConn                       *mobile.Mobile
 	NeptuneConn                      *neptune.Neptune
 	NetworkFirewallConn              *networkfirewall.NetworkFirewall
 	NetworkManagerConn               *networkmanager.NetworkManager
@@ -557,6 +554,7 @@ type AWSClient struct {
 	RoboMakerConn                    *robomaker.RoboMaker
 	Route53Conn                      *route53.Route53
 	Route53DomainsConn               *route53domains.Client
+	Route53ProfilesConn              *route53profiles.Route53Profiles
 	Route53RecoveryClusterConn       *route53recoverycluster.Route53RecoveryCluster
 	Route53RecoveryControlConfigConn *route53recoverycontrolconfig.Route53RecoveryControlConfig
 	Route53RecoveryReadinessConn     *route53recoveryreadiness.Route53RecoveryReadiness
@@ -601,6 +599,7 @@ type AWSClient struct {
 	SupportConn                      *support.Support
 	SyntheticsConn                   *synthetics.Synthetics
 	TextractConn                     *textract.Textract
+	TimestreamInfluxDBConn           *timestreaminfluxdb.TimestreamInfluxDB
 	TimestreamQueryConn              *timestreamquery.TimestreamQuery
 	TimestreamWriteConn              *timestreamwrite.TimestreamWrite
 	TranscribeConn                   *transcribeservice.TranscribeService
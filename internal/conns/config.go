@@ -236,6 +236,22 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
 
+	// S3 Express One Zone directory bucket management operations are served by the
+	// Regional s3express-control endpoint, which only supports path-style requests.
+	// There is no separate s3express endpoint argument, so a custom S3 endpoint is
+	// reused for these operations. Requests are still signed for s3express.
+	s3ExpressControlConfig := &aws.Config{
+		Endpoint:         aws.String(c.Endpoints[names.S3]),
+		S3ForcePathStyle: aws.Bool(true),
+	}
+
+	if aws.StringValue(s3ExpressControlConfig.Endpoint) == "" {
+		s3ExpressControlConfig.Endpoint = aws.String("https://" + client.RegionalHostname("s3express-control"))
+	}
+
+	client.S3ExpressControlConn = s3.New(sess.Copy(s3ExpressControlConfig))
+	client.S3ExpressControlConn.SigningName = "s3express"
+
 	// Force "global" services to correct regions
 	switch partition {
 	case endpoints.AwsPartitionID:
@@ -145,6 +145,7 @@ type AWSClient struct {
 	Region                    string
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
+	S3ExpressControlConn      *s3.S3
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
@@ -69,6 +70,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -86,6 +88,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -115,7 +118,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/location"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/logs"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
@@ -142,6 +144,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53domains"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53recoveryreadiness"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
@@ -157,6 +160,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -169,6 +173,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/swf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
@@ -490,6 +495,9 @@ func Provider() *schema.Provider {
 
 			"aws_codestarconnections_connection": codestarconnections.DataSourceConnection(),
 
+			// Adding the Aliases for the CodeStar Connections -> CodeConnections Rename
+			"aws_codeconnections_connection": codestarconnections.DataSourceConnection(),
+
 			"aws_cognito_user_pool_client":              cognitoidp.DataSourceUserPoolClient(),
 			"aws_cognito_user_pool_clients":             cognitoidp.DataSourceUserPoolClients(),
 			"aws_cognito_user_pool_signing_certificate": cognitoidp.DataSourceUserPoolSigningCertificate(),
@@ -552,6 +560,7 @@ func Provider() *schema.Provider {
 			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
 			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
 			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
 			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
 			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
 			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
@@ -650,6 +659,8 @@ func Provider() *schema.Provider {
 
 			"aws_kinesis_firehose_delivery_stream": firehose.DataSourceDeliveryStream(),
 
+			"aws_fms_policy": fms.DataSourcePolicy(),
+
 			"aws_globalaccelerator_accelerator": globalaccelerator.DataSourceAccelerator(),
 
 			"aws_glue_connection":                       glue.DataSourceConnection(),
@@ -845,8 +856,9 @@ func Provider() *schema.Provider {
 
 			"aws_service_discovery_dns_namespace": servicediscovery.DataSourceDNSNamespace(),
 
-			"aws_servicequotas_service":       servicequotas.DataSourceService(),
-			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
+			"aws_servicequotas_service":        servicequotas.DataSourceService(),
+			"aws_servicequotas_service_quota":  servicequotas.DataSourceServiceQuota(),
+			"aws_servicequotas_service_quotas": servicequotas.DataSourceServiceQuotas(),
 
 			"aws_sfn_activity":      sfn.DataSourceActivity(),
 			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
@@ -896,7 +908,8 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_analyzer":     accessanalyzer.ResourceAnalyzer(),
+			"aws_accessanalyzer_archive_rule": accessanalyzer.ResourceArchiveRule(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
 
@@ -1001,11 +1014,20 @@ func Provider() *schema.Provider {
 			"aws_appsync_function":                    appsync.ResourceFunction(),
 			"aws_appsync_graphql_api":                 appsync.ResourceGraphQLAPI(),
 			"aws_appsync_resolver":                    appsync.ResourceResolver(),
+			"aws_appsync_source_api_association":      appsync.ResourceSourceApiAssociation(),
+
+			"aws_athena_capacity_reservation": athena.ResourceCapacityReservation(),
+			"aws_athena_database":             athena.ResourceDatabase(),
+			"aws_athena_data_catalog":         athena.ResourceDataCatalog(),
+			"aws_athena_named_query":          athena.ResourceNamedQuery(),
+			"aws_athena_prepared_statement":   athena.ResourcePreparedStatement(),
+			"aws_athena_workgroup":            athena.ResourceWorkGroup(),
 
-			"aws_athena_database":     athena.ResourceDatabase(),
-			"aws_athena_data_catalog": athena.ResourceDataCatalog(),
-			"aws_athena_named_query":  athena.ResourceNamedQuery(),
-			"aws_athena_workgroup":    athena.ResourceWorkGroup(),
+			"aws_auditmanager_account_registration":  auditmanager.ResourceAccountRegistration(),
+			"aws_auditmanager_assessment":            auditmanager.ResourceAssessment(),
+			"aws_auditmanager_assessment_delegation": auditmanager.ResourceAssessmentDelegation(),
+			"aws_auditmanager_control":               auditmanager.ResourceControl(),
+			"aws_auditmanager_framework":             auditmanager.ResourceFramework(),
 
 			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
@@ -1053,6 +1075,7 @@ func Provider() *schema.Provider {
 			"aws_cloudcontrolapi_resource": cloudcontrol.ResourceResource(),
 
 			"aws_cloudformation_stack":              cloudformation.ResourceStack(),
+			"aws_cloudformation_stack_instances":    cloudformation.ResourceStackInstances(),
 			"aws_cloudformation_stack_set":          cloudformation.ResourceStackSet(),
 			"aws_cloudformation_stack_set_instance": cloudformation.ResourceStackSetInstance(),
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
@@ -1093,20 +1116,23 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
 
-			"aws_cloudwatch_log_destination":         logs.ResourceDestination(),
-			"aws_cloudwatch_log_destination_policy":  logs.ResourceDestinationPolicy(),
-			"aws_cloudwatch_log_group":               logs.ResourceGroup(),
-			"aws_cloudwatch_log_metric_filter":       logs.ResourceMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":     logs.ResourceResourcePolicy(),
-			"aws_cloudwatch_log_stream":              logs.ResourceStream(),
-			"aws_cloudwatch_log_subscription_filter": logs.ResourceSubscriptionFilter(),
-			"aws_cloudwatch_query_definition":        logs.ResourceQueryDefinition(),
+			"aws_cloudwatch_log_anomaly_detector":       logs.ResourceAnomalyDetector(),
+			"aws_cloudwatch_log_data_protection_policy": logs.ResourceDataProtectionPolicy(),
+			"aws_cloudwatch_log_destination":            logs.ResourceDestination(),
+			"aws_cloudwatch_log_destination_policy":     logs.ResourceDestinationPolicy(),
+			"aws_cloudwatch_log_group":                  logs.ResourceGroup(),
+			"aws_cloudwatch_log_metric_filter":          logs.ResourceMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":        logs.ResourceResourcePolicy(),
+			"aws_cloudwatch_log_stream":                 logs.ResourceStream(),
+			"aws_cloudwatch_log_subscription_filter":    logs.ResourceSubscriptionFilter(),
+			"aws_cloudwatch_query_definition":           logs.ResourceQueryDefinition(),
 
 			"aws_codeartifact_domain":                        codeartifact.ResourceDomain(),
 			"aws_codeartifact_domain_permissions_policy":     codeartifact.ResourceDomainPermissionsPolicy(),
 			"aws_codeartifact_repository":                    codeartifact.ResourceRepository(),
 			"aws_codeartifact_repository_permissions_policy": codeartifact.ResourceRepositoryPermissionsPolicy(),
 
+			"aws_codebuild_fleet":             codebuild.ResourceFleet(),
 			"aws_codebuild_project":           codebuild.ResourceProject(),
 			"aws_codebuild_resource_policy":   codebuild.ResourceResourcePolicy(),
 			"aws_codebuild_report_group":      codebuild.ResourceReportGroup(),
@@ -1128,6 +1154,10 @@ func Provider() *schema.Provider {
 			"aws_codestarconnections_connection": codestarconnections.ResourceConnection(),
 			"aws_codestarconnections_host":       codestarconnections.ResourceHost(),
 
+			// Adding the Aliases for the CodeStar Connections -> CodeConnections Rename
+			"aws_codeconnections_connection": codestarconnections.ResourceConnection(),
+			"aws_codeconnections_host":       codestarconnections.ResourceHost(),
+
 			"aws_codestarnotifications_notification_rule": codestarnotifications.ResourceNotificationRule(),
 
 			"aws_cognito_identity_pool":                        cognitoidentity.ResourcePool(),
@@ -1136,6 +1166,7 @@ func Provider() *schema.Provider {
 
 			"aws_cognito_identity_provider":          cognitoidp.ResourceIdentityProvider(),
 			"aws_cognito_resource_server":            cognitoidp.ResourceResourceServer(),
+			"aws_cognito_risk_configuration":         cognitoidp.ResourceRiskConfiguration(),
 			"aws_cognito_user":                       cognitoidp.ResourceUser(),
 			"aws_cognito_user_group":                 cognitoidp.ResourceUserGroup(),
 			"aws_cognito_user_in_group":              cognitoidp.ResourceUserInGroup(),
@@ -1227,6 +1258,7 @@ func Provider() *schema.Provider {
 			"aws_dms_certificate":              dms.ResourceCertificate(),
 			"aws_dms_endpoint":                 dms.ResourceEndpoint(),
 			"aws_dms_event_subscription":       dms.ResourceEventSubscription(),
+			"aws_dms_replication_config":       dms.ResourceReplicationConfig(),
 			"aws_dms_replication_instance":     dms.ResourceReplicationInstance(),
 			"aws_dms_replication_subnet_group": dms.ResourceReplicationSubnetGroup(),
 			"aws_dms_replication_task":         dms.ResourceReplicationTask(),
@@ -1239,6 +1271,8 @@ func Provider() *schema.Provider {
 			"aws_docdb_global_cluster":          docdb.ResourceGlobalCluster(),
 			"aws_docdb_subnet_group":            docdb.ResourceSubnetGroup(),
 
+			"aws_docdb_elastic_cluster": docdbelastic.ResourceCluster(),
+
 			"aws_directory_service_conditional_forwarder": ds.ResourceConditionalForwarder(),
 			"aws_directory_service_directory":             ds.ResourceDirectory(),
 			"aws_directory_service_log_subscription":      ds.ResourceLogSubscription(),
@@ -1250,118 +1284,125 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                              ec2.ResourceAMI(),
-			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
-			"aws_ec2_host":                                         ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_network_insights_path":                        ec2.ResourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                        ec2.ResourceSerialConsoleAccess(),
-			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                          ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
-			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
-			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
-			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                              ec2.ResourceEIP(),
-			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                         ec2.ResourceFlowLog(),
-			"aws_instance":                                         ec2.ResourceInstance(),
-			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
-			"aws_internet_gateway_attachment":                      ec2.ResourceInternetGatewayAttachment(),
-			"aws_key_pair":                                         ec2.ResourceKeyPair(),
-			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                      ec2.ResourceNATGateway(),
-			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
-			"aws_network_acl_association":                          ec2.ResourceNetworkACLAssociation(),
-			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
-			"aws_route":                                            ec2.ResourceRoute(),
-			"aws_route_table":                                      ec2.ResourceRouteTable(),
-			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                           ec2.ResourceSubnet(),
-			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                              ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_accepter":                 ec2.ResourceVPCEndpointConnectionAccepter(),
-			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_policy":                              ec2.ResourceVPCEndpointPolicy(),
-			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_security_group_association":          ec2.ResourceVPCEndpointSecurityGroupAssociation(),
-			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                         ec2.ResourceIPAM(),
-			"aws_vpc_ipam_organization_admin_account":              ec2.ResourceIPAMOrganizationAdminAccount(),
-			"aws_vpc_ipam_pool":                                    ec2.ResourceIPAMPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceIPAMPoolCIDRAllocation(),
-			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceIPAMPoolCIDR(),
-			"aws_vpc_ipam_preview_next_cidr":                       ec2.ResourceIPAMPreviewNextCIDR(),
-			"aws_vpc_ipam_scope":                                   ec2.ResourceIPAMScope(),
-			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                               ec2.ResourceAMI(),
+			"aws_ami_copy":                                          ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                 ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                             ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                  ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                               ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                               ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                            ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                    ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                       ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                          ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                               ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                         ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                      ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                 ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                               ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                        ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                       ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                          ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                               ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                 ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                           ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":                ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                              ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                         ec2.ResourceFleet(),
+			"aws_ec2_host":                                          ec2.ResourceHost(),
+			"aws_ec2_image_block_public_access":                     ec2.ResourceImageBlockPublicAccess(),
+			"aws_ec2_instance_connect_endpoint":                     ec2.ResourceInstanceConnectEndpoint(),
+			"aws_ec2_local_gateway_route":                           ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":     ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                           ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                     ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_network_insights_path":                         ec2.ResourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                         ec2.ResourceSerialConsoleAccess(),
+			"aws_ec2_subnet_cidr_reservation":                       ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                           ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                         ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                    ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                        ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                         ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                               ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                       ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                  ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":              ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association":  ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":        ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":        ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":            ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":   ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_prefix_list_reference":         ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                         ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                   ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":       ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":       ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":                ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":       ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                      ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                               ec2.ResourceEIP(),
+			"aws_eip_association":                                   ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                          ec2.ResourceFlowLog(),
+			"aws_instance":                                          ec2.ResourceInstance(),
+			"aws_internet_gateway":                                  ec2.ResourceInternetGateway(),
+			"aws_internet_gateway_attachment":                       ec2.ResourceInternetGatewayAttachment(),
+			"aws_key_pair":                                          ec2.ResourceKeyPair(),
+			"aws_launch_template":                                   ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                      ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                       ec2.ResourceNATGateway(),
+			"aws_network_acl":                                       ec2.ResourceNetworkACL(),
+			"aws_network_acl_association":                           ec2.ResourceNetworkACLAssociation(),
+			"aws_network_acl_rule":                                  ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                 ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                      ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                   ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                   ec2.ResourcePlacementGroup(),
+			"aws_route":                                             ec2.ResourceRoute(),
+			"aws_route_table":                                       ec2.ResourceRouteTable(),
+			"aws_route_table_association":                           ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                    ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                               ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                 ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                        ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                                ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                             ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                            ec2.ResourceSubnet(),
+			"aws_verifiedaccess_endpoint":                           ec2.ResourceVerifiedAccessEndpoint(),
+			"aws_verifiedaccess_group":                              ec2.ResourceVerifiedAccessGroup(),
+			"aws_verifiedaccess_instance":                           ec2.ResourceVerifiedAccessInstance(),
+			"aws_verifiedaccess_instance_trust_provider_attachment": ec2.ResourceVerifiedAccessInstanceTrustProviderAttachment(),
+			"aws_verifiedaccess_trust_provider":                     ec2.ResourceVerifiedAccessTrustProvider(),
+			"aws_volume_attachment":                                 ec2.ResourceVolumeAttachment(),
+			"aws_vpc":                                               ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                  ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                      ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                      ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_accepter":                  ec2.ResourceVPCEndpointConnectionAccepter(),
+			"aws_vpc_endpoint_connection_notification":              ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_policy":                               ec2.ResourceVPCEndpointPolicy(),
+			"aws_vpc_endpoint_route_table_association":              ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_security_group_association":           ec2.ResourceVPCEndpointSecurityGroupAssociation(),
+			"aws_vpc_endpoint_service":                              ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":            ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                   ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                          ec2.ResourceIPAM(),
+			"aws_vpc_ipam_organization_admin_account":               ec2.ResourceIPAMOrganizationAdminAccount(),
+			"aws_vpc_ipam_pool":                                     ec2.ResourceIPAMPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                     ec2.ResourceIPAMPoolCIDRAllocation(),
+			"aws_vpc_ipam_pool_cidr":                                ec2.ResourceIPAMPoolCIDR(),
+			"aws_vpc_ipam_preview_next_cidr":                        ec2.ResourceIPAMPreviewNextCIDR(),
+			"aws_vpc_ipam_scope":                                    ec2.ResourceIPAMScope(),
+			"aws_vpc_ipv4_cidr_block_association":                   ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                   ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                            ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                   ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                    ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                    ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                              ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                       ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                            ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                     ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
@@ -1449,12 +1490,19 @@ func Provider() *schema.Provider {
 			"aws_emr_studio":                 emr.ResourceStudio(),
 			"aws_emr_studio_session_mapping": emr.ResourceStudioSessionMapping(),
 
+			"aws_emrcontainers_job_template":    emrcontainers.ResourceJobTemplate(),
 			"aws_emrcontainers_virtual_cluster": emrcontainers.ResourceVirtualCluster(),
 
+			"aws_evidently_feature": evidently.ResourceFeature(),
+			"aws_evidently_launch":  evidently.ResourceLaunch(),
+			"aws_evidently_project": evidently.ResourceProject(),
+			"aws_evidently_segment": evidently.ResourceSegment(),
+
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
 			"aws_fms_admin_account": fms.ResourceAdminAccount(),
 			"aws_fms_policy":        fms.ResourcePolicy(),
+			"aws_fms_resource_set":  fms.ResourceResourceSet(),
 
 			"aws_fsx_backup":                        fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":            fsx.ResourceLustreFileSystem(),
@@ -1503,6 +1551,7 @@ func Provider() *schema.Provider {
 			"aws_grafana_license_association":          grafana.ResourceLicenseAssociation(),
 			"aws_grafana_role_association":             grafana.ResourceRoleAssociation(),
 			"aws_grafana_workspace":                    grafana.ResourceWorkspace(),
+			"aws_grafana_workspace_api_key":            grafana.ResourceWorkspaceAPIKey(),
 			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSamlConfiguration(),
 
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
@@ -1549,6 +1598,8 @@ func Provider() *schema.Provider {
 			"aws_imagebuilder_image_pipeline":               imagebuilder.ResourceImagePipeline(),
 			"aws_imagebuilder_image_recipe":                 imagebuilder.ResourceImageRecipe(),
 			"aws_imagebuilder_infrastructure_configuration": imagebuilder.ResourceInfrastructureConfiguration(),
+			"aws_imagebuilder_lifecycle_policy":             imagebuilder.ResourceLifecyclePolicy(),
+			"aws_imagebuilder_workflow":                     imagebuilder.ResourceWorkflow(),
 
 			"aws_inspector_assessment_target":   inspector.ResourceAssessmentTarget(),
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
@@ -1599,6 +1650,7 @@ func Provider() *schema.Provider {
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
 
@@ -1629,19 +1681,22 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
 
-			"aws_location_map":         location.ResourceMap(),
-			"aws_location_place_index": location.ResourcePlaceIndex(),
-
-			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
-
-			"aws_macie2_account":                    macie2.ResourceAccount(),
-			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
-			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
-			"aws_macie2_findings_filter":            macie2.ResourceFindingsFilter(),
-			"aws_macie2_invitation_accepter":        macie2.ResourceInvitationAccepter(),
-			"aws_macie2_member":                     macie2.ResourceMember(),
-			"aws_macie2_organization_admin_account": macie2.ResourceOrganizationAdminAccount(),
+			"aws_location_geofence_collection": location.ResourceGeofenceCollection(),
+			"aws_location_map":                 location.ResourceMap(),
+			"aws_location_place_index":         location.ResourcePlaceIndex(),
+			"aws_location_route_calculator":    location.ResourceRouteCalculator(),
+			"aws_location_tracker":             location.ResourceTracker(),
+			"aws_location_tracker_association": location.ResourceTrackerAssociation(),
+
+			"aws_macie2_allow_list":                          macie2.ResourceAllowList(),
+			"aws_macie2_account":                             macie2.ResourceAccount(),
+			"aws_macie2_classification_export_configuration": macie2.ResourceClassificationExportConfiguration(),
+			"aws_macie2_classification_job":                  macie2.ResourceClassificationJob(),
+			"aws_macie2_custom_data_identifier":              macie2.ResourceCustomDataIdentifier(),
+			"aws_macie2_findings_filter":                     macie2.ResourceFindingsFilter(),
+			"aws_macie2_invitation_accepter":                 macie2.ResourceInvitationAccepter(),
+			"aws_macie2_member":                              macie2.ResourceMember(),
+			"aws_macie2_organization_admin_account":          macie2.ResourceOrganizationAdminAccount(),
 
 			"aws_media_convert_queue": mediaconvert.ResourceQueue(),
 
@@ -1690,6 +1745,9 @@ func Provider() *schema.Provider {
 			"aws_opensearch_domain":              opensearch.ResourceDomain(),
 			"aws_opensearch_domain_policy":       opensearch.ResourceDomainPolicy(),
 			"aws_opensearch_domain_saml_options": opensearch.ResourceDomainSAMLOptions(),
+			"aws_opensearch_package":             opensearch.ResourcePackage(),
+			"aws_opensearch_package_association": opensearch.ResourcePackageAssociation(),
+			"aws_opensearch_vpc_endpoint":        opensearch.ResourceVPCEndpoint(),
 
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
@@ -1715,6 +1773,7 @@ func Provider() *schema.Provider {
 			"aws_organizations_organizational_unit":     organizations.ResourceOrganizationalUnit(),
 			"aws_organizations_policy":                  organizations.ResourcePolicy(),
 			"aws_organizations_policy_attachment":       organizations.ResourcePolicyAttachment(),
+			"aws_organizations_resource_policy":         organizations.ResourceResourcePolicy(),
 
 			"aws_pinpoint_adm_channel":               pinpoint.ResourceADMChannel(),
 			"aws_pinpoint_apns_channel":              pinpoint.ResourceAPNSChannel(),
@@ -1723,9 +1782,11 @@ func Provider() *schema.Provider {
 			"aws_pinpoint_apns_voip_sandbox_channel": pinpoint.ResourceAPNSVoIPSandboxChannel(),
 			"aws_pinpoint_app":                       pinpoint.ResourceApp(),
 			"aws_pinpoint_baidu_channel":             pinpoint.ResourceBaiduChannel(),
+			"aws_pinpoint_campaign":                  pinpoint.ResourceCampaign(),
 			"aws_pinpoint_email_channel":             pinpoint.ResourceEmailChannel(),
 			"aws_pinpoint_event_stream":              pinpoint.ResourceEventStream(),
 			"aws_pinpoint_gcm_channel":               pinpoint.ResourceGCMChannel(),
+			"aws_pinpoint_segment":                   pinpoint.ResourceSegment(),
 			"aws_pinpoint_sms_channel":               pinpoint.ResourceSMSChannel(),
 
 			"aws_qldb_ledger": qldb.ResourceLedger(),
@@ -1794,6 +1855,10 @@ func Provider() *schema.Provider {
 
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
+			"aws_route53profiles_association":          route53profiles.ResourceAssociation(),
+			"aws_route53profiles_profile":              route53profiles.ResourceProfile(),
+			"aws_route53profiles_resource_association": route53profiles.ResourceResourceAssociation(),
+
 			"aws_route53recoverycontrolconfig_cluster":         route53recoverycontrolconfig.ResourceCluster(),
 			"aws_route53recoverycontrolconfig_control_panel":   route53recoverycontrolconfig.ResourceControlPanel(),
 			"aws_route53recoverycontrolconfig_routing_control": route53recoverycontrolconfig.ResourceRoutingControl(),
@@ -1804,6 +1869,7 @@ func Provider() *schema.Provider {
 			"aws_route53recoveryreadiness_recovery_group":  route53recoveryreadiness.ResourceRecoveryGroup(),
 			"aws_route53recoveryreadiness_resource_set":    route53recoveryreadiness.ResourceResourceSet(),
 
+			"aws_route53_resolver_config":                          route53resolver.ResourceConfig(),
 			"aws_route53_resolver_dnssec_config":                   route53resolver.ResourceDNSSECConfig(),
 			"aws_route53_resolver_endpoint":                        route53resolver.ResourceEndpoint(),
 			"aws_route53_resolver_firewall_config":                 route53resolver.ResourceFirewallConfig(),
@@ -1836,20 +1902,25 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_bucket":                            s3.ResourceDirectoryBucket(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
 
-			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
-			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
-			"aws_s3_account_public_access_block":              s3control.ResourceAccountPublicAccessBlock(),
-			"aws_s3control_bucket":                            s3control.ResourceBucket(),
-			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
-			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
-			"aws_s3control_multi_region_access_point":         s3control.ResourceMultiRegionAccessPoint(),
-			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
-			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
-			"aws_s3control_object_lambda_access_point_policy": s3control.ResourceObjectLambdaAccessPointPolicy(),
+			"aws_s3_access_point":                                  s3control.ResourceAccessPoint(),
+			"aws_s3control_access_grant":                           s3control.ResourceAccessGrant(),
+			"aws_s3control_access_grants_instance":                 s3control.ResourceAccessGrantsInstance(),
+			"aws_s3control_access_grants_instance_resource_policy": s3control.ResourceAccessGrantsInstanceResourcePolicy(),
+			"aws_s3control_access_grants_location":                 s3control.ResourceAccessGrantsLocation(),
+			"aws_s3control_access_point_policy":                    s3control.ResourceAccessPointPolicy(),
+			"aws_s3_account_public_access_block":                   s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3control_bucket":                                 s3control.ResourceBucket(),
+			"aws_s3control_bucket_lifecycle_configuration":         s3control.ResourceBucketLifecycleConfiguration(),
+			"aws_s3control_bucket_policy":                          s3control.ResourceBucketPolicy(),
+			"aws_s3control_multi_region_access_point":              s3control.ResourceMultiRegionAccessPoint(),
+			"aws_s3control_multi_region_access_point_policy":       s3control.ResourceMultiRegionAccessPointPolicy(),
+			"aws_s3control_object_lambda_access_point":             s3control.ResourceObjectLambdaAccessPoint(),
+			"aws_s3control_object_lambda_access_point_policy":      s3control.ResourceObjectLambdaAccessPointPolicy(),
 
 			"aws_s3outposts_endpoint": s3outposts.ResourceEndpoint(),
 
@@ -1920,7 +1991,9 @@ func Provider() *schema.Provider {
 			"aws_service_discovery_public_dns_namespace":  servicediscovery.ResourcePublicDNSNamespace(),
 			"aws_service_discovery_service":               servicediscovery.ResourceService(),
 
-			"aws_servicequotas_service_quota": servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_service_quota":        servicequotas.ResourceServiceQuota(),
+			"aws_servicequotas_template":             servicequotas.ResourceTemplate(),
+			"aws_servicequotas_template_association": servicequotas.ResourceTemplateAssociation(),
 
 			"aws_ses_active_receipt_rule_set":      ses.ResourceActiveReceiptRuleSet(),
 			"aws_ses_configuration_set":            ses.ResourceConfigurationSet(),
@@ -1937,12 +2010,22 @@ func Provider() *schema.Provider {
 			"aws_ses_receipt_rule_set":             ses.ResourceReceiptRuleSet(),
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
+			"aws_sesv2_dedicated_ip_pool":                   sesv2.ResourceDedicatedIPPool(),
+			"aws_sesv2_email_identity":                      sesv2.ResourceEmailIdentity(),
+			"aws_sesv2_email_identity_feedback_attributes":  sesv2.ResourceEmailIdentityFeedbackAttributes(),
+			"aws_sesv2_email_identity_mail_from_attributes": sesv2.ResourceEmailIdentityMailFromAttributes(),
+
 			"aws_sfn_activity":      sfn.ResourceActivity(),
+			"aws_sfn_alias":         sfn.ResourceAlias(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
-			"aws_shield_protection":                          shield.ResourceProtection(),
-			"aws_shield_protection_group":                    shield.ResourceProtectionGroup(),
-			"aws_shield_protection_health_check_association": shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_application_layer_automatic_response": shield.ResourceApplicationLayerAutomaticResponse(),
+			"aws_shield_drt_access_log_bucket_association":    shield.ResourceDRTAccessLogBucketAssociation(),
+			"aws_shield_drt_access_role_arn_association":      shield.ResourceDRTAccessRoleARNAssociation(),
+			"aws_shield_protection":                           shield.ResourceProtection(),
+			"aws_shield_protection_group":                     shield.ResourceProtectionGroup(),
+			"aws_shield_protection_health_check_association":  shield.ResourceProtectionHealthCheckAssociation(),
+			"aws_shield_subscription":                         shield.ResourceSubscription(),
 
 			"aws_signer_signing_job":                signer.ResourceSigningJob(),
 			"aws_signer_signing_profile":            signer.ResourceSigningProfile(),
@@ -1970,10 +2053,16 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":                   ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_application":                          ssoadmin.ResourceApplication(),
+			"aws_ssoadmin_application_assignment":               ssoadmin.ResourceApplicationAssignment(),
+			"aws_ssoadmin_application_assignment_configuration": ssoadmin.ResourceApplicationAssignmentConfiguration(),
+			"aws_ssoadmin_customer_managed_policy_attachment":   ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":            ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                       ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":         ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":      ssoadmin.ResourcePermissionsBoundaryAttachment(),
+			"aws_ssoadmin_trusted_token_issuer":                 ssoadmin.ResourceTrustedTokenIssuer(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -1988,7 +2077,11 @@ func Provider() *schema.Provider {
 
 			"aws_swf_domain": swf.ResourceDomain(),
 
-			"aws_synthetics_canary": synthetics.ResourceCanary(),
+			"aws_synthetics_canary":            synthetics.ResourceCanary(),
+			"aws_synthetics_group":             synthetics.ResourceGroup(),
+			"aws_synthetics_group_association": synthetics.ResourceGroupAssociation(),
+
+			"aws_timestreaminfluxdb_db_instance": timestreaminfluxdb.ResourceDBInstance(),
 
 			"aws_timestreamwrite_database": timestreamwrite.ResourceDatabase(),
 			"aws_timestreamwrite_table":    timestreamwrite.ResourceTable(),
@@ -17,6 +17,12 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			"disappears":        testAccAnalyzer_disappears,
 			"Tags":              testAccAnalyzer_Tags,
 			"Type_Organization": testAccAnalyzer_Type_Organization,
+			"UnusedAccess":      testAccAnalyzer_unusedAccess,
+		},
+		"ArchiveRule": {
+			"basic":         testAccArchiveRule_basic,
+			"disappears":    testAccArchiveRule_disappears,
+			"updateFilters": testAccArchiveRule_updateFilters,
 		},
 	}
 
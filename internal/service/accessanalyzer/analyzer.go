@@ -50,17 +50,43 @@ func ResourceAnalyzer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"unused_access": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"unused_access_age": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 180),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"type": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Default:  accessanalyzer.TypeAccount,
-				ValidateFunc: validation.StringInSlice([]string{
-					accessanalyzer.TypeAccount,
-					accessanalyzer.TypeOrganization,
-				}, false),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      accessanalyzer.TypeAccount,
+				ValidateFunc: validation.StringInSlice(accessanalyzer.Type_Values(), false),
 			},
 		},
 
@@ -81,6 +107,10 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 		Type:         aws.String(d.Get("type").(string)),
 	}
 
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	// Handle Organizations eventual consistency
 	err := resource.Retry(organizationCreationTimeout, func() *resource.RetryError {
 		_, err := conn.CreateAnalyzer(input)
@@ -137,6 +167,10 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("analyzer_name", output.Analyzer.Name)
 	d.Set("arn", output.Analyzer.Arn)
 
+	if err := d.Set("configuration", flattenAnalyzerConfiguration(output.Analyzer.Configuration)); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
 	tags := KeyValueTags(output.Analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -185,3 +219,45 @@ func resourceAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandAnalyzerConfiguration(tfMap map[string]interface{}) *accessanalyzer.AnalyzerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &accessanalyzer.AnalyzerConfiguration{}
+
+	if v, ok := tfMap["unused_access"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.UnusedAccess = expandUnusedAccessConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandUnusedAccessConfiguration(tfMap map[string]interface{}) *accessanalyzer.UnusedAccessConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &accessanalyzer.UnusedAccessConfiguration{}
+
+	if v, ok := tfMap["unused_access_age"].(int); ok && v != 0 {
+		apiObject.UnusedAccessAge = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenAnalyzerConfiguration(apiObject *accessanalyzer.AnalyzerConfiguration) []interface{} {
+	if apiObject == nil || apiObject.UnusedAccess == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"unused_access": []interface{}{map[string]interface{}{
+			"unused_access_age": aws.Int64Value(apiObject.UnusedAccess.UnusedAccessAge),
+		}},
+	}
+
+	return []interface{}{tfMap}
+}
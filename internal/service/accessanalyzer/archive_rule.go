@@ -0,0 +1,283 @@
+package accessanalyzer
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceArchiveRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArchiveRuleCreate,
+		Read:   resourceArchiveRuleRead,
+		Update: resourceArchiveRuleUpdate,
+		Delete: resourceArchiveRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 20,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceArchiveRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName := d.Get("analyzer_name").(string)
+	ruleName := d.Get("rule_name").(string)
+	id := ArchiveRuleCreateResourceID(analyzerName, ruleName)
+
+	input := &accessanalyzer.CreateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       expandArchiveRuleFilter(d.Get("filter").(*schema.Set).List()),
+		RuleName:     aws.String(ruleName),
+	}
+
+	_, err := conn.CreateArchiveRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Access Analyzer Archive Rule (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	archiveRule, err := FindArchiveRuleByTwoPartKey(conn, analyzerName, ruleName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Access Analyzer Archive Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	d.Set("analyzer_name", analyzerName)
+	if err := d.Set("filter", flattenArchiveRuleFilter(archiveRule.Filter)); err != nil {
+		return fmt.Errorf("error setting filter: %w", err)
+	}
+	d.Set("rule_name", archiveRule.RuleName)
+
+	return nil
+}
+
+func resourceArchiveRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &accessanalyzer.UpdateArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		Filter:       expandArchiveRuleFilter(d.Get("filter").(*schema.Set).List()),
+		RuleName:     aws.String(ruleName),
+	}
+
+	_, err = conn.UpdateArchiveRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	return resourceArchiveRuleRead(d, meta)
+}
+
+func resourceArchiveRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+
+	analyzerName, ruleName, err := ArchiveRuleParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Access Analyzer Archive Rule: (%s)", d.Id())
+	_, err = conn.DeleteArchiveRule(&accessanalyzer.DeleteArchiveRuleInput{
+		AnalyzerName: aws.String(analyzerName),
+		ClientToken:  aws.String(resource.UniqueId()),
+		RuleName:     aws.String(ruleName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Access Analyzer Archive Rule (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const archiveRuleResourceIDSeparator = "/"
+
+func ArchiveRuleCreateResourceID(analyzerName, ruleName string) string {
+	parts := []string{analyzerName, ruleName}
+	id := strings.Join(parts, archiveRuleResourceIDSeparator)
+
+	return id
+}
+
+func ArchiveRuleParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, archiveRuleResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ANALYZER_NAME%[2]sRULE_NAME", id, archiveRuleResourceIDSeparator)
+}
+
+func expandArchiveRuleFilter(tfList []interface{}) map[string]*accessanalyzer.Criterion {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*accessanalyzer.Criterion)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &accessanalyzer.Criterion{}
+
+		if v, ok := tfMap["contains"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Contains = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["eq"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Eq = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["exists"].(string); ok && v != "" {
+			apiObject.Exists = aws.Bool(v == "true")
+		}
+
+		if v, ok := tfMap["neq"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Neq = flex.ExpandStringList(v)
+		}
+
+		apiObjects[tfMap["criteria"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenArchiveRuleFilter(apiObjects map[string]*accessanalyzer.Criterion) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for criteria, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"criteria": criteria,
+		}
+
+		if v := apiObject.Contains; len(v) > 0 {
+			tfMap["contains"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.Eq; len(v) > 0 {
+			tfMap["eq"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.Exists; v != nil {
+			tfMap["exists"] = fmt.Sprintf("%t", aws.BoolValue(v))
+		}
+
+		if v := apiObject.Neq; len(v) > 0 {
+			tfMap["neq"] = aws.StringValueSlice(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
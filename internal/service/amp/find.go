@@ -37,6 +37,31 @@ func FindAlertManagerDefinitionByID(ctx context.Context, conn *prometheusservice
 	return output.AlertManagerDefinition, nil
 }
 
+func FindLoggingConfigurationByWorkspaceID(ctx context.Context, conn *prometheusservice.PrometheusService, id string) (*prometheusservice.LoggingConfigurationMetadata, error) {
+	input := &prometheusservice.DescribeLoggingConfigurationInput{
+		WorkspaceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeLoggingConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LoggingConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.LoggingConfiguration, nil
+}
+
 func nameAndWorkspaceIDFromRuleGroupNamespaceARN(arn string) (string, string, error) {
 	parts := strings.Split(arn, "/")
 	if len(parts) != 3 {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,7 +23,7 @@ func ResourceRuleGroupNamespace() *schema.Resource {
 		UpdateContext: resourceRuleGroupNamespaceUpdate,
 		DeleteContext: resourceRuleGroupNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceRuleGroupNamespaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -142,3 +144,37 @@ func resourceRuleGroupNamespaceDelete(ctx context.Context, d *schema.ResourceDat
 
 	return nil
 }
+
+// resourceRuleGroupNamespaceImport accepts either the rule group namespace ARN
+// or a "workspace_id/name" pair, which is resolved to the ARN.
+func resourceRuleGroupNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if arn.IsARN(d.Id()) {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected ARN or WORKSPACE-ID/NAME", d.Id())
+	}
+
+	conn := meta.(*conns.AWSClient).AMPConn
+	workspaceID, name := parts[0], parts[1]
+
+	output, err := conn.DescribeRuleGroupsNamespaceWithContext(ctx, &prometheusservice.DescribeRuleGroupsNamespaceInput{
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Prometheus Rule Group Namespace (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || output.RuleGroupsNamespace == nil {
+		return nil, fmt.Errorf("error reading Prometheus Rule Group Namespace (%s): empty result", d.Id())
+	}
+
+	d.SetId(aws.StringValue(output.RuleGroupsNamespace.Arn))
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -35,6 +35,12 @@ func TestAccAMPRuleGroupNamespace_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRuleGroupNamespaceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAMPRuleGroupNamespace(anotherRuleGroupNamespace()),
 				Check: resource.ComposeTestCheckFunc(
@@ -96,6 +102,17 @@ func testAccCheckRuleGroupNamespaceExists(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccRuleGroupNamespaceImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckAMPRuleGroupNamespaceDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AMPConn
 
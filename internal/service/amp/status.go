@@ -32,6 +32,22 @@ func statusAlertManagerDefinition(ctx context.Context, conn *prometheusservice.P
 	}
 }
 
+func statusLoggingConfiguration(ctx context.Context, conn *prometheusservice.PrometheusService, workspaceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLoggingConfigurationByWorkspaceID(ctx, conn, workspaceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.StatusCode), nil
+	}
+}
+
 func statusRuleGroupNamespace(ctx context.Context, conn *prometheusservice.PrometheusService, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindRuleGroupNamespaceByARN(ctx, conn, arn)
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -32,6 +33,20 @@ func ResourceWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"logging_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"prometheus_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,6 +91,20 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("arn", ws.Arn)
 	d.Set("prometheus_endpoint", ws.PrometheusEndpoint)
 
+	loggingConfiguration, err := FindLoggingConfigurationByWorkspaceID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		d.Set("logging_configuration", nil)
+	} else if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Prometheus Workspace (%s) logging configuration: %w", d.Id(), err))
+	} else {
+		if err := d.Set("logging_configuration", []interface{}{map[string]interface{}{
+			"log_group_arn": aws.StringValue(loggingConfiguration.LogGroupArn),
+		}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting logging_configuration: %w", err))
+		}
+	}
+
 	tags, err := ListTags(conn, *ws.Arn)
 
 	if err != nil {
@@ -118,6 +147,35 @@ func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(fmt.Errorf("error updating Prometheus WorkSpace (%s): %w", d.Id(), err))
 	}
 
+	if d.HasChange("logging_configuration") {
+		o, n := d.GetChange("logging_configuration")
+
+		switch {
+		case len(o.([]interface{})) == 0:
+			if err := createLoggingConfiguration(ctx, conn, d.Id(), n.([]interface{})); err != nil {
+				return diag.FromErr(err)
+			}
+		case len(n.([]interface{})) == 0:
+			if err := deleteLoggingConfiguration(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		default:
+			tfMap := n.([]interface{})[0].(map[string]interface{})
+			input := &prometheusservice.UpdateLoggingConfigurationInput{
+				LogGroupArn: aws.String(tfMap["log_group_arn"].(string)),
+				WorkspaceId: aws.String(d.Id()),
+			}
+
+			if _, err := conn.UpdateLoggingConfigurationWithContext(ctx, input); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating Prometheus Workspace (%s) logging configuration: %w", d.Id(), err))
+			}
+
+			if _, err := waitLoggingConfigurationUpdated(ctx, conn, d.Id()); err != nil {
+				return diag.FromErr(fmt.Errorf("error waiting for Prometheus Workspace (%s) logging configuration update: %w", d.Id(), err))
+			}
+		}
+	}
+
 	return resourceWorkspaceRead(ctx, d, meta)
 }
 
@@ -146,6 +204,12 @@ func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(fmt.Errorf("error waiting for Workspace (%s) to be created: %w", d.Id(), err))
 	}
 
+	if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 {
+		if err := createLoggingConfiguration(ctx, conn, d.Id(), v.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceWorkspaceRead(ctx, d, meta)
 }
 
@@ -171,3 +235,45 @@ func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	return nil
 }
+
+func createLoggingConfiguration(ctx context.Context, conn *prometheusservice.PrometheusService, workspaceID string, tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	input := &prometheusservice.CreateLoggingConfigurationInput{
+		LogGroupArn: aws.String(tfMap["log_group_arn"].(string)),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	if _, err := conn.CreateLoggingConfigurationWithContext(ctx, input); err != nil {
+		return fmt.Errorf("error creating Prometheus Workspace (%s) logging configuration: %w", workspaceID, err)
+	}
+
+	if _, err := waitLoggingConfigurationCreated(ctx, conn, workspaceID); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Workspace (%s) logging configuration create: %w", workspaceID, err)
+	}
+
+	return nil
+}
+
+func deleteLoggingConfiguration(ctx context.Context, conn *prometheusservice.PrometheusService, workspaceID string) error {
+	_, err := conn.DeleteLoggingConfigurationWithContext(ctx, &prometheusservice.DeleteLoggingConfigurationInput{
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, prometheusservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Prometheus Workspace (%s) logging configuration: %w", workspaceID, err)
+	}
+
+	if _, err := waitLoggingConfigurationDeleted(ctx, conn, workspaceID); err != nil {
+		return fmt.Errorf("error waiting for Prometheus Workspace (%s) logging configuration delete: %w", workspaceID, err)
+	}
+
+	return nil
+}
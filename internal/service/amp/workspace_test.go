@@ -132,6 +132,48 @@ func TestAccAMPWorkspace_tags(t *testing.T) {
 	})
 }
 
+func TestAccAMPWorkspace_loggingConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_prometheus_workspace.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(prometheusservice.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, prometheusservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAMPWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMPWorkspaceLoggingConfigurationConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMPWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.log_group_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAMPWorkspaceLoggingConfigurationConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMPWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.log_group_arn"),
+				),
+			},
+			{
+				Config: testAccAMPWorkspaceWithoutLoggingConfigurationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMPWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAMPWorkspaceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -238,3 +280,33 @@ resource "aws_prometheus_workspace" "test" {
 }
 `, randInt)
 }
+
+func testAccAMPWorkspaceLoggingConfigurationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+`, rName)
+}
+
+func testAccAMPWorkspaceLoggingConfigurationConfig(rName string, idx int) string {
+	return acctest.ConfigCompose(testAccAMPWorkspaceLoggingConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {
+  alias = %[1]q
+
+  logging_configuration {
+    log_group_arn = "${aws_cloudwatch_log_group.test[%[2]d].arn}:*"
+  }
+}
+`, rName, idx))
+}
+
+func testAccAMPWorkspaceWithoutLoggingConfigurationConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAMPWorkspaceLoggingConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_prometheus_workspace" "test" {
+  alias = %[1]q
+}
+`, rName))
+}
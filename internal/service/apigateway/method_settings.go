@@ -3,6 +3,8 @@ package apigateway
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -245,6 +247,25 @@ func resourceMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	restApiId := d.Get("rest_api_id").(string)
 	stageName := d.Get("stage_name").(string)
+
+	mutexKey := methodSettingsMutexKey(restApiId, stageName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// Patching the */* method path also overwrites the same settings on every
+	// specific method path. Capture the specific settings beforehand so they
+	// can be restored afterwards.
+	var existingMethodSettings map[string]*apigateway.MethodSetting
+	if methodPath == methodSettingsAllMethodsPath && len(ops) > 0 {
+		stage, err := FindStageByName(conn, restApiId, stageName)
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway Stage (%s): %w", stageName, err)
+		}
+
+		existingMethodSettings = stage.MethodSettings
+	}
+
 	input := apigateway.UpdateStageInput{
 		RestApiId:       aws.String(restApiId),
 		StageName:       aws.String(stageName),
@@ -258,6 +279,17 @@ func resourceMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("updating API Gateway Stage failed: %w", err)
 	}
 
+	if len(existingMethodSettings) > 0 {
+		keys := make([]string, 0, len(ops))
+		for _, op := range ops {
+			keys = append(keys, strings.TrimPrefix(aws.StringValue(op.Path), prefix))
+		}
+
+		if err := restoreMethodSettings(conn, restApiId, stageName, existingMethodSettings, keys); err != nil {
+			return fmt.Errorf("error restoring API Gateway Stage (%s) method settings: %w", stageName, err)
+		}
+	}
+
 	d.SetId(restApiId + "-" + stageName + "-" + methodPath)
 
 	return resourceMethodSettingsRead(d, meta)
@@ -266,13 +298,39 @@ func resourceMethodSettingsUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceMethodSettingsDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 
+	restApiId := d.Get("rest_api_id").(string)
+	stageName := d.Get("stage_name").(string)
+	methodPath := d.Get("method_path").(string)
+
+	mutexKey := methodSettingsMutexKey(restApiId, stageName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// Removing the */* method path also resets the settings inherited by every
+	// specific method path. Capture the specific settings beforehand so they
+	// can be restored afterwards.
+	var existingMethodSettings map[string]*apigateway.MethodSetting
+	if methodPath == methodSettingsAllMethodsPath {
+		stage, err := FindStageByName(conn, restApiId, stageName)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading API Gateway Stage (%s): %w", stageName, err)
+		}
+
+		existingMethodSettings = stage.MethodSettings
+	}
+
 	input := &apigateway.UpdateStageInput{
-		RestApiId: aws.String(d.Get("rest_api_id").(string)),
-		StageName: aws.String(d.Get("stage_name").(string)),
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
 		PatchOperations: []*apigateway.PatchOperation{
 			{
 				Op:   aws.String(apigateway.OpRemove),
-				Path: aws.String(fmt.Sprintf("/%s", d.Get("method_path").(string))),
+				Path: aws.String(fmt.Sprintf("/%s", methodPath)),
 			},
 		},
 	}
@@ -292,6 +350,18 @@ func resourceMethodSettingsDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("error deleting API Gateway Stage Method Settings (%s): %w", d.Id(), err)
 	}
 
+	if len(existingMethodSettings) > 1 {
+		keys := make([]string, 0, len(methodSettingDefaults))
+		for key := range methodSettingDefaults {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		if err := restoreMethodSettings(conn, restApiId, stageName, existingMethodSettings, keys); err != nil {
+			return fmt.Errorf("error restoring API Gateway Stage (%s) method settings: %w", stageName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -309,3 +379,128 @@ func resourceMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*
 	d.SetId(fmt.Sprintf("%s-%s-%s", restApiID, stageName, methodPath))
 	return []*schema.ResourceData{d}, nil
 }
+
+const methodSettingsAllMethodsPath = "*/*"
+
+func methodSettingsMutexKey(restAPIID, stageName string) string {
+	return fmt.Sprintf("api-gateway-stage-method-settings-%s-%s", restAPIID, stageName)
+}
+
+// methodSettingValues returns the current value of each method setting keyed by
+// its patch operation path relative to the method path. Settings that are not
+// set are omitted.
+func methodSettingValues(settings *apigateway.MethodSetting) map[string]string {
+	if settings == nil {
+		return nil
+	}
+
+	m := make(map[string]string)
+
+	if v := settings.MetricsEnabled; v != nil {
+		m["metrics/enabled"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := settings.LoggingLevel; v != nil {
+		m["logging/loglevel"] = aws.StringValue(v)
+	}
+	if v := settings.DataTraceEnabled; v != nil {
+		m["logging/dataTrace"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := settings.ThrottlingBurstLimit; v != nil {
+		m["throttling/burstLimit"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+	if v := settings.ThrottlingRateLimit; v != nil {
+		m["throttling/rateLimit"] = strconv.FormatFloat(aws.Float64Value(v), 'f', -1, 64)
+	}
+	if v := settings.CachingEnabled; v != nil {
+		m["caching/enabled"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := settings.CacheTtlInSeconds; v != nil {
+		m["caching/ttlInSeconds"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+	if v := settings.CacheDataEncrypted; v != nil {
+		m["caching/dataEncrypted"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := settings.RequireAuthorizationForCacheControl; v != nil {
+		m["caching/requireAuthorizationForCacheControl"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+	if v := settings.UnauthorizedCacheControlHeaderStrategy; v != nil {
+		m["caching/unauthorizedCacheControlHeaderStrategy"] = aws.StringValue(v)
+	}
+
+	return m
+}
+
+// methodSettingDefaults are the values API Gateway reports for a method path
+// that has no setting of its own and no */* setting to inherit from.
+var methodSettingDefaults = map[string]string{
+	"metrics/enabled":                                "false",
+	"logging/loglevel":                               "OFF",
+	"logging/dataTrace":                              "false",
+	"throttling/burstLimit":                          "-1",
+	"throttling/rateLimit":                           "-1",
+	"caching/enabled":                                "false",
+	"caching/ttlInSeconds":                           "300",
+	"caching/dataEncrypted":                          "false",
+	"caching/requireAuthorizationForCacheControl":    "true",
+	"caching/unauthorizedCacheControlHeaderStrategy": apigateway.UnauthorizedCacheControlHeaderStrategySucceedWithResponseHeader,
+}
+
+// restoreMethodSettings re-applies specific method path settings that were
+// overwritten by patching or removing the */* method path.
+//
+// GetStage reports every setting on every method path, including values that
+// are only inherited from */*. A setting is treated as explicitly set on a
+// method path only when it differed from the */* value (or the API default
+// when there was no */* setting) before the change; inherited settings are
+// left to follow the new */* value.
+func restoreMethodSettings(conn *apigateway.APIGateway, restAPIID, stageName string, existing map[string]*apigateway.MethodSetting, keys []string) error {
+	stage, err := FindStageByName(conn, restAPIID, stageName)
+
+	if err != nil {
+		return err
+	}
+
+	inherited := methodSettingDefaults
+	if v, ok := existing[methodSettingsAllMethodsPath]; ok {
+		inherited = methodSettingValues(v)
+	}
+
+	ops := make([]*apigateway.PatchOperation, 0)
+	for methodPath, settings := range existing {
+		if methodPath == methodSettingsAllMethodsPath {
+			continue
+		}
+
+		oldValues := methodSettingValues(settings)
+		newValues := methodSettingValues(stage.MethodSettings[methodPath])
+
+		for _, key := range keys {
+			oldValue, ok := oldValues[key]
+
+			if !ok || oldValue == inherited[key] || oldValue == newValues[key] {
+				continue
+			}
+
+			ops = append(ops, &apigateway.PatchOperation{
+				Op:    aws.String(apigateway.OpReplace),
+				Path:  aws.String(fmt.Sprintf("/%s/%s", methodPath, key)),
+				Value: aws.String(oldValue),
+			})
+		}
+	}
+
+	if len(ops) == 0 {
+		return nil
+	}
+
+	input := &apigateway.UpdateStageInput{
+		RestApiId:       aws.String(restAPIID),
+		StageName:       aws.String(stageName),
+		PatchOperations: ops,
+	}
+
+	log.Printf("[DEBUG] Restoring API Gateway Stage method settings: %s", input)
+	_, err = conn.UpdateStage(input)
+
+	return err
+}
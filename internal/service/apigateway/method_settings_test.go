@@ -321,6 +321,55 @@ func TestAccAPIGatewayMethodSettings_Settings_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayMethodSettings_Settings_allAndSpecificMethods(t *testing.T) {
+	var stage1, stage2 apigateway.Stage
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allResourceName := "aws_api_gateway_method_settings.all"
+	resourceName := "aws_api_gateway_method_settings.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, apigateway.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMethodSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMethodSettingsSettingsAllAndSpecificMethodsConfig(rName, "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage1),
+					testAccCheckMethodSettings_loggingLevel(&stage1, "*/*", "INFO"),
+					testAccCheckMethodSettings_metricsEnabled(&stage1, "*/*", true),
+					testAccCheckMethodSettings_loggingLevel(&stage1, "test/GET", "ERROR"),
+					testAccCheckMethodSettings_metricsEnabled(&stage1, "test/GET", false),
+					resource.TestCheckResourceAttr(allResourceName, "settings.0.logging_level", "INFO"),
+					resource.TestCheckResourceAttr(allResourceName, "settings.0.metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.cache_data_encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.cache_ttl_in_seconds", "30"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.require_authorization_for_cache_control", "true"),
+				),
+			},
+			{
+				Config: testAccMethodSettingsSettingsAllAndSpecificMethodsConfig(rName, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(resourceName, &stage2),
+					testAccCheckMethodSettings_loggingLevel(&stage2, "*/*", "OFF"),
+					testAccCheckMethodSettings_loggingLevel(&stage2, "test/GET", "ERROR"),
+					resource.TestCheckResourceAttr(allResourceName, "settings.0.logging_level", "OFF"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.logging_level", "ERROR"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccMethodSettingsImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayMethodSettings_Settings_requireAuthorizationForCacheControl(t *testing.T) {
 	var stage1, stage2 apigateway.Stage
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -789,6 +838,35 @@ resource "aws_api_gateway_method_settings" "test" {
 `, loggingLevel, metricsEnabled)
 }
 
+func testAccMethodSettingsSettingsAllAndSpecificMethodsConfig(rName, allLoggingLevel string) string {
+	return testAccMethodSettingsBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_method_settings" "all" {
+  method_path = "*/*"
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_deployment.test.stage_name
+
+  settings {
+    logging_level   = %[1]q
+    metrics_enabled = true
+  }
+}
+
+resource "aws_api_gateway_method_settings" "test" {
+  method_path = "${aws_api_gateway_resource.test.path_part}/${aws_api_gateway_method.test.http_method}"
+  rest_api_id = aws_api_gateway_rest_api.test.id
+  stage_name  = aws_api_gateway_deployment.test.stage_name
+
+  settings {
+    cache_data_encrypted                    = true
+    cache_ttl_in_seconds                    = 30
+    logging_level                           = "ERROR"
+    metrics_enabled                         = false
+    require_authorization_for_cache_control = true
+  }
+}
+`, allLoggingLevel)
+}
+
 func testAccMethodSettingsSettingsRequireAuthorizationForCacheControlConfig(rName string, requireAuthorizationForCacheControl bool) string {
 	return testAccMethodSettingsBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_api_gateway_method_settings" "test" {
@@ -1,6 +1,7 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -95,7 +96,23 @@ func ResourceRoute() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
 		},
+
+		CustomizeDiff: resourceRouteCustomizeDiff,
+	}
+}
+
+func resourceRouteCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("authorization_scopes") || !diff.NewValueKnown("authorization_type") {
+		return nil
 	}
+
+	if v := diff.Get("authorization_scopes").(*schema.Set); v.Len() > 0 {
+		if authorizationType := diff.Get("authorization_type").(string); authorizationType != apigatewayv2.AuthorizationTypeJwt {
+			return fmt.Errorf("authorization_scopes can only be set when authorization_type is %s, got: %s", apigatewayv2.AuthorizationTypeJwt, authorizationType)
+		}
+	}
+
+	return nil
 }
 
 func resourceRouteCreate(d *schema.ResourceData, meta interface{}) error {
@@ -2,6 +2,7 @@ package apigatewayv2_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -192,6 +193,23 @@ func TestAccAPIGatewayV2Route_jwtAuthorization(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayV2Route_authorizationScopesRequireJWT(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRouteConfig_authorizationScopesWithoutJWT(rName),
+				ExpectError: regexp.MustCompile(`authorization_scopes can only be set when authorization_type is JWT`),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayV2Route_model(t *testing.T) {
 	var apiId string
 	var v apigatewayv2.GetRouteOutput
@@ -643,6 +661,21 @@ resource "aws_apigatewayv2_route" "test" {
 `)
 }
 
+func testAccRouteConfig_authorizationScopesWithoutJWT(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAuthorizerConfig_jwt(rName),
+		`
+resource "aws_apigatewayv2_route" "test" {
+  api_id    = aws_apigatewayv2_api.test.id
+  route_key = "GET /test"
+
+  authorization_type = "NONE"
+
+  authorization_scopes = ["user.email"]
+}
+`)
+}
+
 func testAccRouteConfig_model(rName string) string {
 	schema := `
 {
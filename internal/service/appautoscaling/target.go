@@ -12,20 +12,26 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTargetPut,
+		Create: resourceTargetCreate,
 		Read:   resourceTargetRead,
-		Update: resourceTargetPut,
+		Update: resourceTargetUpdate,
 		Delete: resourceTargetDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceTargetImport,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"max_capacity": {
 				Type:     schema.TypeInt,
 				Required: true,
@@ -54,57 +60,83 @@ func ResourceTarget() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"suspended_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_scaling_in_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dynamic_scaling_out_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scheduled_scaling_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
-func resourceTargetPut(d *schema.ResourceData, meta interface{}) error {
+func resourceTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var targetOpts applicationautoscaling.RegisterScalableTargetInput
+	input := expandRegisterScalableTargetInput(d)
 
-	targetOpts.MaxCapacity = aws.Int64(int64(d.Get("max_capacity").(int)))
-	targetOpts.MinCapacity = aws.Int64(int64(d.Get("min_capacity").(int)))
-	targetOpts.ResourceId = aws.String(d.Get("resource_id").(string))
-	targetOpts.ScalableDimension = aws.String(d.Get("scalable_dimension").(string))
-	targetOpts.ServiceNamespace = aws.String(d.Get("service_namespace").(string))
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
 
-	if roleArn, exists := d.GetOk("role_arn"); exists {
-		targetOpts.RoleARN = aws.String(roleArn.(string))
+	if err := registerScalableTarget(conn, input); err != nil {
+		return fmt.Errorf("creating Application AutoScaling Target: %w", err)
 	}
 
-	log.Printf("[DEBUG] Application autoscaling target create configuration %s", targetOpts)
-	var err error
-	err = resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err = conn.RegisterScalableTarget(&targetOpts)
+	d.SetId(d.Get("resource_id").(string))
 
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "Unable to assume IAM role") {
-				return resource.RetryableError(err)
-			}
-			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "ECS service doesn't exist") {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
+	return resourceTargetRead(d, meta)
+}
 
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.RegisterScalableTarget(&targetOpts)
-	}
+func resourceTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppAutoScalingConn
 
-	if err != nil {
-		return fmt.Errorf("creating Application AutoScaling Target: %w", err)
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := registerScalableTarget(conn, expandRegisterScalableTargetInput(d)); err != nil {
+			return fmt.Errorf("updating Application AutoScaling Target (%s): %w", d.Id(), err)
+		}
 	}
 
-	d.SetId(d.Get("resource_id").(string))
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("updating Application AutoScaling Target (%s) tags: %w", d.Id(), err)
+		}
+	}
 
 	return resourceTargetRead(d, meta)
 }
 
 func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppAutoScalingConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(2*time.Minute,
 		func() (interface{}, error) {
@@ -125,6 +157,7 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	t := outputRaw.(*applicationautoscaling.ScalableTarget)
 
+	d.Set("arn", t.ScalableTargetARN)
 	d.Set("max_capacity", t.MaxCapacity)
 	d.Set("min_capacity", t.MinCapacity)
 	d.Set("resource_id", t.ResourceId)
@@ -132,6 +165,27 @@ func resourceTargetRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("scalable_dimension", t.ScalableDimension)
 	d.Set("service_namespace", t.ServiceNamespace)
 
+	if err := d.Set("suspended_state", flattenSuspendedState(t.SuspendedState)); err != nil {
+		return fmt.Errorf("setting suspended_state: %w", err)
+	}
+
+	tags, err := ListTags(conn, aws.StringValue(t.ScalableTargetARN))
+
+	if err != nil {
+		return fmt.Errorf("listing tags for Application AutoScaling Target (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
@@ -233,3 +287,84 @@ func resourceTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.R
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func expandRegisterScalableTargetInput(d *schema.ResourceData) *applicationautoscaling.RegisterScalableTargetInput {
+	input := &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws.Int64(int64(d.Get("max_capacity").(int))),
+		MinCapacity:       aws.Int64(int64(d.Get("min_capacity").(int))),
+		ResourceId:        aws.String(d.Get("resource_id").(string)),
+		ScalableDimension: aws.String(d.Get("scalable_dimension").(string)),
+		ServiceNamespace:  aws.String(d.Get("service_namespace").(string)),
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("suspended_state"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SuspendedState = expandSuspendedState(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return input
+}
+
+func registerScalableTarget(conn *applicationautoscaling.ApplicationAutoScaling, input *applicationautoscaling.RegisterScalableTargetInput) error {
+	log.Printf("[DEBUG] Registering Application AutoScaling Target: %s", input)
+	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
+		_, err := conn.RegisterScalableTarget(input)
+
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "Unable to assume IAM role") {
+				return resource.RetryableError(err)
+			}
+			if tfawserr.ErrMessageContains(err, applicationautoscaling.ErrCodeValidationException, "ECS service doesn't exist") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.RegisterScalableTarget(input)
+	}
+
+	return err
+}
+
+func expandSuspendedState(tfMap map[string]interface{}) *applicationautoscaling.SuspendedState {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &applicationautoscaling.SuspendedState{}
+
+	if v, ok := tfMap["dynamic_scaling_in_suspended"].(bool); ok {
+		apiObject.DynamicScalingInSuspended = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["dynamic_scaling_out_suspended"].(bool); ok {
+		apiObject.DynamicScalingOutSuspended = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["scheduled_scaling_suspended"].(bool); ok {
+		apiObject.ScheduledScalingSuspended = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenSuspendedState(apiObject *applicationautoscaling.SuspendedState) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dynamic_scaling_in_suspended":  aws.BoolValue(apiObject.DynamicScalingInSuspended),
+		"dynamic_scaling_out_suspended": aws.BoolValue(apiObject.DynamicScalingOutSuspended),
+		"scheduled_scaling_suspended":   aws.BoolValue(apiObject.ScheduledScalingSuspended),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -2,6 +2,7 @@ package appautoscaling_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -193,6 +194,106 @@ func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingTarget_suspendedState(t *testing.T) {
+	var target applicationautoscaling.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+	policyResourceName := "aws_appautoscaling_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetSuspendedStateConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetSuspendedStateConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", "true"),
+					resource.TestCheckResourceAttr(policyResourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccTargetSuspendedStateConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", "false"),
+					resource.TestCheckResourceAttr(policyResourceName, "name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppAutoScalingTarget_tags(t *testing.T) {
+	var target applicationautoscaling.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, applicationautoscaling.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "application-autoscaling", regexp.MustCompile(`scalable-target/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTargetTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn
 
@@ -783,6 +884,109 @@ resource "aws_appautoscaling_target" "read" {
 `, tableName)
 }
 
+func testAccTargetSuspendedStateConfig(rName string, suspended bool) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 2
+  max_capacity       = 15
+
+  suspended_state {
+    dynamic_scaling_in_suspended  = %[2]t
+    dynamic_scaling_out_suspended = %[2]t
+    scheduled_scaling_suspended   = %[2]t
+  }
+}
+
+resource "aws_appautoscaling_policy" "test" {
+  name               = %[1]q
+  policy_type        = "TargetTrackingScaling"
+  resource_id        = aws_appautoscaling_target.test.resource_id
+  scalable_dimension = aws_appautoscaling_target.test.scalable_dimension
+  service_namespace  = aws_appautoscaling_target.test.service_namespace
+
+  target_tracking_scaling_policy_configuration {
+    predefined_metric_specification {
+      predefined_metric_type = "DynamoDBReadCapacityUtilization"
+    }
+
+    target_value = 70
+  }
+}
+`, rName, suspended)
+}
+
+func testAccTargetTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 2
+  max_capacity       = 15
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccTargetTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 5
+  write_capacity = 5
+  hash_key       = "FooKey"
+
+  attribute {
+    name = "FooKey"
+    type = "S"
+  }
+}
+
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "dynamodb"
+  resource_id        = "table/${aws_dynamodb_table.test.name}"
+  scalable_dimension = "dynamodb:table:ReadCapacityUnits"
+  min_capacity       = 2
+  max_capacity       = 15
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
 func testAccTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
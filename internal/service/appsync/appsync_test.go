@@ -58,6 +58,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			"AdditionalAuthentication_awsLambda":        testAccGraphQLAPI_AdditionalAuthentication_lambda,
 			"AdditionalAuthentication_multiple":         testAccGraphQLAPI_AdditionalAuthentication_multiple,
 			"xrayEnabled":                               testAccGraphQLAPI_xrayEnabled,
+			"mergedAPI":                                 testAccGraphQLAPI_mergedAPI,
+			"enhancedMetricsConfig":                     testAccGraphQLAPI_enhancedMetricsConfig,
+			"introspectionConfig":                       testAccGraphQLAPI_introspectionConfig,
+			"queryDepthLimit":                           testAccGraphQLAPI_queryDepthLimit,
+			"resolverCountLimit":                        testAccGraphQLAPI_resolverCountLimit,
 		},
 		"Function": {
 			"basic":                   testAccFunction_basic,
@@ -91,6 +96,10 @@ func TestAccAppSync_serial(t *testing.T) {
 			"basic":      testAccDomainNameAPIAssociation_basic,
 			"disappears": testAccDomainNameAPIAssociation_disappears,
 		},
+		"SourceAPIAssociation": {
+			"basic":      testAccSourceAPIAssociation_basic,
+			"disappears": testAccSourceAPIAssociation_disappears,
+		},
 	}
 
 	for group, m := range testCases {
@@ -79,3 +79,28 @@ func FindDomainNameApiAssociationByID(conn *appsync.AppSync, id string) (*appsyn
 
 	return out.ApiAssociation, nil
 }
+
+func FindSourceApiAssociationByTwoPartKey(conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	input := &appsync.GetSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+	out, err := conn.GetSourceApiAssociation(input)
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.SourceApiAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return out.SourceApiAssociation, nil
+}
@@ -1,6 +1,7 @@
 package appsync
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -115,11 +117,53 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"api_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appsync.GraphQLApiTypeGraphql,
+				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiType_Values(), false),
+			},
 			"authentication_type": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
 			},
+			"enhanced_metrics_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_source_level_metrics_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.DataSourceLevelMetricsBehavior_Values(), false),
+						},
+						"operation_level_metrics_config": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.OperationLevelMetricsConfig_Values(), false),
+						},
+						"resolver_level_metrics_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.ResolverLevelMetricsBehavior_Values(), false),
+						},
+					},
+				},
+			},
+			"introspection_config": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      appsync.GraphQLApiIntrospectionConfigEnabled,
+				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiIntrospectionConfig_Values(), false),
+			},
+			"merged_api_execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"schema": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -240,6 +284,16 @@ func ResourceGraphQLAPI() *schema.Resource {
 					},
 				},
 			},
+			"query_depth_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 75),
+			},
+			"resolver_count_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -257,7 +311,10 @@ func ResourceGraphQLAPI() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffGraphQLAPIMergedAPI,
+		),
 	}
 }
 
@@ -267,8 +324,26 @@ func resourceGraphQLAPICreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	input := &appsync.CreateGraphqlApiInput{
-		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
-		Name:               aws.String(d.Get("name").(string)),
+		ApiType:             aws.String(d.Get("api_type").(string)),
+		AuthenticationType:  aws.String(d.Get("authentication_type").(string)),
+		IntrospectionConfig: aws.String(d.Get("introspection_config").(string)),
+		Name:                aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("enhanced_metrics_config"); ok {
+		input.EnhancedMetricsConfig = expandGraphQLAPIEnhancedMetricsConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_depth_limit"); ok {
+		input.QueryDepthLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("resolver_count_limit"); ok {
+		input.ResolverCountLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("log_config"); ok {
@@ -334,10 +409,20 @@ func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting AppSync GraphQL API (%s): %s", d.Id(), err)
 	}
 
+	d.Set("api_type", resp.GraphqlApi.ApiType)
 	d.Set("arn", resp.GraphqlApi.Arn)
 	d.Set("authentication_type", resp.GraphqlApi.AuthenticationType)
+	d.Set("merged_api_execution_role_arn", resp.GraphqlApi.MergedApiExecutionRoleArn)
 	d.Set("name", resp.GraphqlApi.Name)
 
+	d.Set("introspection_config", resp.GraphqlApi.IntrospectionConfig)
+	d.Set("query_depth_limit", resp.GraphqlApi.QueryDepthLimit)
+	d.Set("resolver_count_limit", resp.GraphqlApi.ResolverCountLimit)
+
+	if err := d.Set("enhanced_metrics_config", flattenGraphQLAPIEnhancedMetricsConfig(resp.GraphqlApi.EnhancedMetricsConfig)); err != nil {
+		return fmt.Errorf("error setting enhanced_metrics_config: %s", err)
+	}
+
 	if err := d.Set("log_config", flattenGraphQLAPILogConfig(resp.GraphqlApi.LogConfig)); err != nil {
 		return fmt.Errorf("error setting log_config: %s", err)
 	}
@@ -392,15 +477,26 @@ func resourceGraphQLAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	input := &appsync.UpdateGraphqlApiInput{
-		ApiId:              aws.String(d.Id()),
-		AuthenticationType: aws.String(d.Get("authentication_type").(string)),
-		Name:               aws.String(d.Get("name").(string)),
+		ApiId:               aws.String(d.Id()),
+		AuthenticationType:  aws.String(d.Get("authentication_type").(string)),
+		IntrospectionConfig: aws.String(d.Get("introspection_config").(string)),
+		Name:                aws.String(d.Get("name").(string)),
+		QueryDepthLimit:     aws.Int64(int64(d.Get("query_depth_limit").(int))),
+		ResolverCountLimit:  aws.Int64(int64(d.Get("resolver_count_limit").(int))),
+	}
+
+	if v, ok := d.GetOk("enhanced_metrics_config"); ok {
+		input.EnhancedMetricsConfig = expandGraphQLAPIEnhancedMetricsConfig(v.([]interface{}))
 	}
 
 	if v, ok := d.GetOk("log_config"); ok {
 		input.LogConfig = expandGraphQLAPILogConfig(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("merged_api_execution_role_arn"); ok {
+		input.MergedApiExecutionRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("openid_connect_config"); ok {
 		input.OpenIDConnectConfig = expandGraphQLAPIOpenIDConnectConfig(v.([]interface{}))
 	}
@@ -454,6 +550,24 @@ func resourceGraphQLAPIDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func customizeDiffGraphQLAPIMergedAPI(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	apiType := diff.Get("api_type").(string)
+
+	if apiType == appsync.GraphQLApiTypeMerged {
+		if diff.NewValueKnown("merged_api_execution_role_arn") && diff.Get("merged_api_execution_role_arn").(string) == "" {
+			return fmt.Errorf("merged_api_execution_role_arn is required when api_type is %s", appsync.GraphQLApiTypeMerged)
+		}
+
+		if v, ok := diff.GetOk("schema"); ok && v.(string) != "" {
+			return fmt.Errorf("schema cannot be set when api_type is %s", appsync.GraphQLApiTypeMerged)
+		}
+	} else if diff.NewValueKnown("merged_api_execution_role_arn") && diff.Get("merged_api_execution_role_arn").(string) != "" {
+		return fmt.Errorf("merged_api_execution_role_arn can only be set when api_type is %s", appsync.GraphQLApiTypeMerged)
+	}
+
+	return nil
+}
+
 func expandGraphQLAPILogConfig(l []interface{}) *appsync.LogConfig {
 	if len(l) < 1 || l[0] == nil {
 		return nil
@@ -596,6 +710,36 @@ func expandGraphQLAPICognitoUserPoolConfig(l []interface{}, currentRegion string
 	return userPoolConfig
 }
 
+func expandGraphQLAPIEnhancedMetricsConfig(l []interface{}) *appsync.EnhancedMetricsConfig {
+	if len(l) < 1 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	enhancedMetricsConfig := &appsync.EnhancedMetricsConfig{
+		DataSourceLevelMetricsBehavior: aws.String(m["data_source_level_metrics_behavior"].(string)),
+		OperationLevelMetricsConfig:    aws.String(m["operation_level_metrics_config"].(string)),
+		ResolverLevelMetricsBehavior:   aws.String(m["resolver_level_metrics_behavior"].(string)),
+	}
+
+	return enhancedMetricsConfig
+}
+
+func flattenGraphQLAPIEnhancedMetricsConfig(enhancedMetricsConfig *appsync.EnhancedMetricsConfig) []interface{} {
+	if enhancedMetricsConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"data_source_level_metrics_behavior": aws.StringValue(enhancedMetricsConfig.DataSourceLevelMetricsBehavior),
+		"operation_level_metrics_config":     aws.StringValue(enhancedMetricsConfig.OperationLevelMetricsConfig),
+		"resolver_level_metrics_behavior":    aws.StringValue(enhancedMetricsConfig.ResolverLevelMetricsBehavior),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenGraphQLAPILogConfig(logConfig *appsync.LogConfig) []interface{} {
 	if logConfig == nil {
 		return []interface{}{}
@@ -0,0 +1,272 @@
+package appsync
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSourceApiAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSourceApiAssociationCreate,
+		Read:   resourceSourceApiAssociationRead,
+		Update: resourceSourceApiAssociationUpdate,
+		Delete: resourceSourceApiAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"merged_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"merged_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_api_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_api_association_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"merge_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(appsync.MergeType_Values(), false),
+						},
+					},
+				},
+			},
+			"source_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSourceApiAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID := d.Get("merged_api_id").(string)
+	input := &appsync.AssociateSourceGraphqlApiInput{
+		MergedApiIdentifier: aws.String(mergedAPIID),
+		SourceApiIdentifier: aws.String(d.Get("source_api_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceApiAssociationConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating AppSync Source API Association: %s", input)
+	output, err := conn.AssociateSourceGraphqlApi(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating AppSync Source API Association: %w", err)
+	}
+
+	associationID := aws.StringValue(output.SourceApiAssociation.AssociationId)
+	d.SetId(SourceApiAssociationCreateResourceID(mergedAPIID, associationID))
+
+	if sourceApiAssociationAutoMerges(output.SourceApiAssociation) {
+		if _, err := waitSourceApiAssociationMerged(conn, mergedAPIID, associationID); err != nil {
+			return fmt.Errorf("error waiting for AppSync Source API Association (%s) merge: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSourceApiAssociationRead(d, meta)
+}
+
+func resourceSourceApiAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceApiAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	association, err := FindSourceApiAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppSync Source API Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", association.AssociationArn)
+	d.Set("association_id", association.AssociationId)
+	d.Set("description", association.Description)
+	d.Set("merged_api_arn", association.MergedApiArn)
+	d.Set("merged_api_id", mergedAPIID)
+	d.Set("source_api_arn", association.SourceApiArn)
+	d.Set("source_api_id", association.SourceApiId)
+
+	if err := d.Set("source_api_association_config", flattenSourceApiAssociationConfig(association.SourceApiAssociationConfig)); err != nil {
+		return fmt.Errorf("error setting source_api_association_config: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSourceApiAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceApiAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &appsync.UpdateSourceApiAssociationInput{
+		AssociationId:       aws.String(associationID),
+		Description:         aws.String(d.Get("description").(string)),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	}
+
+	if v, ok := d.GetOk("source_api_association_config"); ok {
+		input.SourceApiAssociationConfig = expandSourceApiAssociationConfig(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating AppSync Source API Association: %s", input)
+	output, err := conn.UpdateSourceApiAssociation(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	if sourceApiAssociationAutoMerges(output.SourceApiAssociation) {
+		if _, err := waitSourceApiAssociationMerged(conn, mergedAPIID, associationID); err != nil {
+			return fmt.Errorf("error waiting for AppSync Source API Association (%s) merge: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSourceApiAssociationRead(d, meta)
+}
+
+func resourceSourceApiAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AppSyncConn
+
+	mergedAPIID, associationID, err := SourceApiAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting AppSync Source API Association: %s", d.Id())
+	_, err = conn.DisassociateSourceGraphqlApi(&appsync.DisassociateSourceGraphqlApiInput{
+		AssociationId:       aws.String(associationID),
+		MergedApiIdentifier: aws.String(mergedAPIID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting AppSync Source API Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitSourceApiAssociationDeleted(conn, mergedAPIID, associationID); err != nil {
+		return fmt.Errorf("error waiting for AppSync Source API Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const sourceApiAssociationResourceIDSeparator = ","
+
+func SourceApiAssociationCreateResourceID(mergedAPIID, associationID string) string {
+	parts := []string{mergedAPIID, associationID}
+	id := strings.Join(parts, sourceApiAssociationResourceIDSeparator)
+
+	return id
+}
+
+func SourceApiAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, sourceApiAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected MERGED-API-ID%[2]sASSOCIATION-ID", id, sourceApiAssociationResourceIDSeparator)
+}
+
+// sourceApiAssociationAutoMerges returns whether changes to the association are
+// merged into the Merged API automatically. Manually merged associations are
+// only merged when a merge is started outside of Terraform, so there is nothing
+// to wait for.
+func sourceApiAssociationAutoMerges(association *appsync.SourceApiAssociation) bool {
+	if association == nil || association.SourceApiAssociationConfig == nil {
+		return false
+	}
+
+	return aws.StringValue(association.SourceApiAssociationConfig.MergeType) == appsync.MergeTypeAutoMerge
+}
+
+func expandSourceApiAssociationConfig(tfList []interface{}) *appsync.SourceApiAssociationConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &appsync.SourceApiAssociationConfig{}
+
+	if v, ok := tfMap["merge_type"].(string); ok && v != "" {
+		apiObject.MergeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSourceApiAssociationConfig(apiObject *appsync.SourceApiAssociationConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"merge_type": aws.StringValue(apiObject.MergeType),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -38,3 +38,19 @@ func statusDomainNameApiAssociation(conn *appsync.AppSync, id string) resource.S
 		return output, aws.StringValue(output.AssociationStatus), nil
 	}
 }
+
+func statusSourceApiAssociation(conn *appsync.AppSync, mergedAPIID, associationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSourceApiAssociationByTwoPartKey(conn, mergedAPIID, associationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SourceApiAssociationStatus), nil
+	}
+}
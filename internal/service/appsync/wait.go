@@ -1,10 +1,13 @@
 package appsync
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +15,8 @@ const (
 	apiCacheDeletedTimeout             = 60 * time.Minute
 	domainNameApiAssociationTimeout    = 60 * time.Minute
 	domainNameApiDisassociationTimeout = 60 * time.Minute
+	sourceApiAssociationMergedTimeout  = 10 * time.Minute
+	sourceApiAssociationDeletedTimeout = 10 * time.Minute
 )
 
 func waitApiCacheAvailable(conn *appsync.AppSync, id string) error {
@@ -65,3 +70,41 @@ func waitDomainNameApiDisassociation(conn *appsync.AppSync, id string) error {
 
 	return err
 }
+
+func waitSourceApiAssociationMerged(conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusMergeScheduled, appsync.SourceApiAssociationStatusMergeInProgress},
+		Target:  []string{appsync.SourceApiAssociationStatusMergeSuccess},
+		Refresh: statusSourceApiAssociation(conn, mergedAPIID, associationID),
+		Timeout: sourceApiAssociationMergedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSourceApiAssociationDeleted(conn *appsync.AppSync, mergedAPIID, associationID string) (*appsync.SourceApiAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appsync.SourceApiAssociationStatusDeletionScheduled, appsync.SourceApiAssociationStatusDeletionInProgress},
+		Target:  []string{},
+		Refresh: statusSourceApiAssociation(conn, mergedAPIID, associationID),
+		Timeout: sourceApiAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*appsync.SourceApiAssociation); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SourceApiAssociationStatusDetail)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,188 @@
+package athena
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePreparedStatementCreate,
+		ReadContext:   resourcePreparedStatementRead,
+		UpdateContext: resourcePreparedStatementUpdate,
+		DeleteContext: resourcePreparedStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_@:]*$`), "must start with a letter or underscore and contain only alphanumeric characters, underscores, at signs, and colons"),
+				),
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePreparedStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	workGroupName, statementName := d.Get("workgroup").(string), d.Get("name").(string)
+	id := PreparedStatementCreateResourceID(workGroupName, statementName)
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Prepared Statement: %s", input)
+	_, err := conn.CreatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Athena Prepared Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePreparedStatementRead(ctx, d, meta)
+}
+
+func resourcePreparedStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	preparedStatement, err := FindPreparedStatementByTwoPartKey(ctx, conn, workGroupName, statementName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set("description", preparedStatement.Description)
+	d.Set("name", preparedStatement.StatementName)
+	d.Set("query_statement", preparedStatement.QueryStatement)
+	d.Set("workgroup", preparedStatement.WorkGroupName)
+
+	return nil
+}
+
+func resourcePreparedStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Athena Prepared Statement: %s", input)
+	_, err = conn.UpdatePreparedStatementWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return resourcePreparedStatementRead(ctx, d, meta)
+}
+
+func resourcePreparedStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AthenaConn
+
+	workGroupName, statementName, err := PreparedStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Prepared Statement: (%s)", d.Id())
+	_, err = conn.DeletePreparedStatementWithContext(ctx, &athena.DeletePreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Athena Prepared Statement (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const preparedStatementResourceIDSeparator = "/"
+
+func PreparedStatementCreateResourceID(workGroupName, statementName string) string {
+	parts := []string{workGroupName, statementName}
+	id := strings.Join(parts, preparedStatementResourceIDSeparator)
+
+	return id
+}
+
+func PreparedStatementParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, preparedStatementResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKGROUP-NAME%[2]sSTATEMENT-NAME", id, preparedStatementResourceIDSeparator)
+}
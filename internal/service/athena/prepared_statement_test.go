@@ -0,0 +1,202 @@
+package athena_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAthenaPreparedStatement_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE id = ?"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfathena.ResourcePreparedStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("tf_acc_test")
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, athena.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPreparedStatementConfig_description(rName, "description 1", "SELECT * FROM test WHERE id = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE id = ?"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPreparedStatementConfig_description(rName, "description 2", "SELECT * FROM test WHERE id = ? AND name = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT * FROM test WHERE id = ? AND name = ?"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPreparedStatementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_prepared_statement" {
+			continue
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfathena.FindPreparedStatementByTwoPartKey(context.Background(), conn, workGroupName, statementName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Prepared Statement %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPreparedStatementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Prepared Statement ID is set")
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+
+		_, err = tfathena.FindPreparedStatementByTwoPartKey(context.Background(), conn, workGroupName, statementName)
+
+		return err
+	}
+}
+
+func testAccPreparedStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = replace(%[1]q, "_", "-")
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/output"
+    }
+  }
+}
+
+resource "aws_athena_database" "test" {
+  name   = %[1]q
+  bucket = aws_s3_bucket.test.bucket
+}
+`, rName)
+}
+
+func testAccPreparedStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPreparedStatementConfig_base(rName), fmt.Sprintf(`
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[1]q
+  query_statement = "SELECT * FROM test WHERE id = ?"
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName))
+}
+
+func testAccPreparedStatementConfig_description(rName, description, queryStatement string) string {
+	return acctest.ConfigCompose(testAccPreparedStatementConfig_base(rName), fmt.Sprintf(`
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[1]q
+  description     = %[2]q
+  query_statement = %[3]q
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, description, queryStatement))
+}
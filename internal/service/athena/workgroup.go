@@ -46,6 +46,11 @@ func ResourceWorkGroup() *schema.Resource {
 								validation.IntInSlice([]int{0}),
 							),
 						},
+						"enable_minimum_encryption_configuration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"enforce_workgroup_configuration": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -342,6 +347,10 @@ func expandWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfiguratio
 		configuration.BytesScannedCutoffPerQuery = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["enable_minimum_encryption_configuration"].(bool); ok && v {
+		configuration.EnableMinimumEncryptionConfiguration = aws.Bool(v)
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"]; ok {
 		configuration.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
@@ -396,6 +405,10 @@ func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfi
 		configurationUpdates.RemoveBytesScannedCutoffPerQuery = aws.Bool(true)
 	}
 
+	if v, ok := m["enable_minimum_encryption_configuration"]; ok {
+		configurationUpdates.EnableMinimumEncryptionConfiguration = aws.Bool(v.(bool))
+	}
+
 	if v, ok := m["enforce_workgroup_configuration"]; ok {
 		configurationUpdates.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
@@ -408,8 +421,15 @@ func expandWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGroupConfi
 		configurationUpdates.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
 
-	if v, ok := m["result_configuration"]; ok {
-		configurationUpdates.ResultConfigurationUpdates = expandWorkGroupResultConfigurationUpdates(v.([]interface{}))
+	if v, ok := m["result_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		configurationUpdates.ResultConfigurationUpdates = expandWorkGroupResultConfigurationUpdates(v)
+	} else {
+		configurationUpdates.ResultConfigurationUpdates = &athena.ResultConfigurationUpdates{
+			RemoveAclConfiguration:        aws.Bool(true),
+			RemoveEncryptionConfiguration: aws.Bool(true),
+			RemoveExpectedBucketOwner:     aws.Bool(true),
+			RemoveOutputLocation:          aws.Bool(true),
+		}
 	}
 
 	if v, ok := m["requester_pays_enabled"]; ok {
@@ -456,8 +476,8 @@ func expandWorkGroupResultConfigurationUpdates(l []interface{}) *athena.ResultCo
 
 	resultConfigurationUpdates := &athena.ResultConfigurationUpdates{}
 
-	if v, ok := m["encryption_configuration"]; ok {
-		resultConfigurationUpdates.EncryptionConfiguration = expandWorkGroupEncryptionConfiguration(v.([]interface{}))
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		resultConfigurationUpdates.EncryptionConfiguration = expandWorkGroupEncryptionConfiguration(v)
 	} else {
 		resultConfigurationUpdates.RemoveEncryptionConfiguration = aws.Bool(true)
 	}
@@ -474,8 +494,8 @@ func expandWorkGroupResultConfigurationUpdates(l []interface{}) *athena.ResultCo
 		resultConfigurationUpdates.RemoveExpectedBucketOwner = aws.Bool(true)
 	}
 
-	if v, ok := m["acl_configuration"]; ok {
-		resultConfigurationUpdates.AclConfiguration = expandResultConfigurationAclConfig(v.([]interface{}))
+	if v, ok := m["acl_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		resultConfigurationUpdates.AclConfiguration = expandResultConfigurationAclConfig(v)
 	} else {
 		resultConfigurationUpdates.RemoveAclConfiguration = aws.Bool(true)
 	}
@@ -509,12 +529,13 @@ func flattenWorkGroupConfiguration(configuration *athena.WorkGroupConfiguration)
 	}
 
 	m := map[string]interface{}{
-		"bytes_scanned_cutoff_per_query":     aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
-		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
-		"engine_version":                     flattenWorkGroupEngineVersion(configuration.EngineVersion),
-		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
-		"result_configuration":               flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
-		"requester_pays_enabled":             aws.BoolValue(configuration.RequesterPaysEnabled),
+		"bytes_scanned_cutoff_per_query":          aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
+		"enable_minimum_encryption_configuration": aws.BoolValue(configuration.EnableMinimumEncryptionConfiguration),
+		"enforce_workgroup_configuration":         aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                          flattenWorkGroupEngineVersion(configuration.EngineVersion),
+		"publish_cloudwatch_metrics_enabled":      aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
+		"result_configuration":                    flattenWorkGroupResultConfiguration(configuration.ResultConfiguration),
+		"requester_pays_enabled":                  aws.BoolValue(configuration.RequesterPaysEnabled),
 	}
 
 	return []interface{}{m}
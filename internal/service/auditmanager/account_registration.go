@@ -0,0 +1,192 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccountRegistration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAccountRegistrationCreate,
+		ReadWithoutTimeout:   resourceAccountRegistrationRead,
+		UpdateWithoutTimeout: resourceAccountRegistrationUpdate,
+		DeleteWithoutTimeout: resourceAccountRegistrationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"delegated_admin_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"deregister_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAccountRegistrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	// Registration is per-region, so the region is used as the resource ID.
+	id := meta.(*conns.AWSClient).Region
+
+	if err := registerAccount(ctx, conn, d); err != nil {
+		return diag.Errorf("registering Audit Manager account (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	status, err := FindAccountRegistrationStatus(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager account registration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager account registration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("status", status)
+
+	output, err := conn.GetSettingsWithContext(ctx, &auditmanager.GetSettingsInput{
+		Attribute: aws.String(auditmanager.SettingAttributeAll),
+	})
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager account registration (%s) settings: %s", d.Id(), err)
+	}
+
+	if output.Settings != nil {
+		// The API reports "DEFAULT" when the AWS owned key is in use.
+		if v := aws.StringValue(output.Settings.KmsKey); v != "DEFAULT" {
+			d.Set("kms_key", v)
+		} else {
+			d.Set("kms_key", nil)
+		}
+	}
+
+	if _, ok := d.GetOk("delegated_admin_account"); ok {
+		output, err := conn.GetOrganizationAdminAccountWithContext(ctx, &auditmanager.GetOrganizationAdminAccountInput{})
+
+		if err != nil {
+			return diag.Errorf("reading Audit Manager account registration (%s) delegated administrator: %s", d.Id(), err)
+		}
+
+		d.Set("delegated_admin_account", output.AdminAccountId)
+	}
+
+	return nil
+}
+
+func resourceAccountRegistrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChanges("delegated_admin_account", "kms_key") {
+		if err := registerAccount(ctx, conn, d); err != nil {
+			return diag.Errorf("updating Audit Manager account registration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAccountRegistrationRead(ctx, d, meta)
+}
+
+func resourceAccountRegistrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if !d.Get("deregister_on_destroy").(bool) {
+		log.Printf("[DEBUG] Audit Manager account registration (%s) remains registered, removing from state", d.Id())
+		return nil
+	}
+
+	if v, ok := d.GetOk("delegated_admin_account"); ok {
+		log.Printf("[INFO] Deregistering Audit Manager delegated administrator: %s", v.(string))
+		_, err := conn.DeregisterOrganizationAdminAccountWithContext(ctx, &auditmanager.DeregisterOrganizationAdminAccountInput{
+			AdminAccountId: aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return diag.Errorf("deregistering Audit Manager delegated administrator (%s): %s", v.(string), err)
+		}
+	}
+
+	log.Printf("[INFO] Deregistering Audit Manager account: %s", d.Id())
+	_, err := conn.DeregisterAccountWithContext(ctx, &auditmanager.DeregisterAccountInput{})
+
+	if err != nil {
+		return diag.Errorf("deregistering Audit Manager account (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func registerAccount(ctx context.Context, conn *auditmanager.AuditManager, d *schema.ResourceData) error {
+	input := &auditmanager.RegisterAccountInput{}
+
+	if v, ok := d.GetOk("delegated_admin_account"); ok {
+		input.DelegatedAdminAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key"); ok {
+		input.KmsKey = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Registering Audit Manager account: %s", input)
+	_, err := conn.RegisterAccountWithContext(ctx, input)
+
+	return err
+}
+
+func FindAccountRegistrationStatus(ctx context.Context, conn *auditmanager.AuditManager) (string, error) {
+	input := &auditmanager.GetAccountStatusInput{}
+
+	output, err := conn.GetAccountStatusWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == auditmanager.AccountStatusInactive {
+		return "", &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return aws.StringValue(output.Status), nil
+}
@@ -0,0 +1,98 @@
+package auditmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfauditmanager "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
+)
+
+func TestAccAuditManagerAccountRegistration_basic(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", auditmanager.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deregister_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccAuditManagerAccountRegistration_kmsKey(t *testing.T) {
+	resourceName := "aws_auditmanager_account_registration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(auditmanager.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, auditmanager.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountRegistrationConfig_kmsKey(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountRegistrationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRegistrationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Audit Manager account registration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AuditManagerConn
+
+		_, err := tfauditmanager.FindAccountRegistrationStatus(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccAccountRegistrationConfig_basic() string {
+	return `
+resource "aws_auditmanager_account_registration" "test" {}
+`
+}
+
+func testAccAccountRegistrationConfig_kmsKey() string {
+	return `
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_auditmanager_account_registration" "test" {
+  deregister_on_destroy = true
+  kms_key               = aws_kms_key.test.arn
+}
+`
+}
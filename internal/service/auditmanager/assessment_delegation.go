@@ -0,0 +1,201 @@
+package auditmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAssessmentDelegation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssessmentDelegationCreate,
+		ReadWithoutTimeout:   resourceAssessmentDelegationRead,
+		DeleteWithoutTimeout: resourceAssessmentDelegationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assessment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 350),
+			},
+			"control_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"delegation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"role_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(auditmanager.RoleType_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssessmentDelegationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID := d.Get("assessment_id").(string)
+	delegation := &auditmanager.CreateDelegationRequest{
+		ControlSetId: aws.String(d.Get("control_set_id").(string)),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		RoleType:     aws.String(d.Get("role_type").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		delegation.Comment = aws.String(v.(string))
+	}
+
+	input := &auditmanager.BatchCreateDelegationByAssessmentInput{
+		AssessmentId:             aws.String(assessmentID),
+		CreateDelegationRequests: []*auditmanager.CreateDelegationRequest{delegation},
+	}
+
+	log.Printf("[INFO] Creating Audit Manager Assessment Delegation: %s", input)
+	output, err := conn.BatchCreateDelegationByAssessmentWithContext(ctx, input)
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if err == nil && (output == nil || len(output.Delegations) == 0 || output.Delegations[0] == nil) {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Assessment (%s) Delegation: %s", assessmentID, err)
+	}
+
+	d.SetId(AssessmentDelegationCreateResourceID(assessmentID, aws.StringValue(output.Delegations[0].Id)))
+
+	return resourceAssessmentDelegationRead(ctx, d, meta)
+}
+
+func resourceAssessmentDelegationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	delegation, err := FindAssessmentDelegationByTwoPartKey(ctx, conn, assessmentID, delegationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Assessment Delegation (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	d.Set("assessment_id", delegation.AssessmentId)
+	d.Set("comment", delegation.Comment)
+	d.Set("control_set_id", delegation.ControlSetId)
+	d.Set("delegation_id", delegation.Id)
+	d.Set("role_arn", delegation.RoleArn)
+	d.Set("role_type", delegation.RoleType)
+	d.Set("status", delegation.Status)
+
+	return nil
+}
+
+func resourceAssessmentDelegationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	assessmentID, delegationID, err := AssessmentDelegationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting Audit Manager Assessment Delegation: %s", d.Id())
+	output, err := conn.BatchDeleteDelegationByAssessmentWithContext(ctx, &auditmanager.BatchDeleteDelegationByAssessmentInput{
+		AssessmentId:  aws.String(assessmentID),
+		DelegationIds: aws.StringSlice([]string{delegationID}),
+	})
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = fmt.Errorf("%s: %s", aws.StringValue(output.Errors[0].ErrorCode), aws.StringValue(output.Errors[0].ErrorMessage))
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Assessment Delegation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindAssessmentDelegationByTwoPartKey(ctx context.Context, conn *auditmanager.AuditManager, assessmentID, delegationID string) (*auditmanager.Delegation, error) {
+	assessment, err := FindAssessmentByID(ctx, conn, assessmentID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range assessment.Metadata.Delegations {
+		if aws.StringValue(v.Id) == delegationID {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{}
+}
+
+const assessmentDelegationResourceIDSeparator = ","
+
+func AssessmentDelegationCreateResourceID(assessmentID, delegationID string) string {
+	parts := []string{assessmentID, delegationID}
+	id := strings.Join(parts, assessmentDelegationResourceIDSeparator)
+
+	return id
+}
+
+func AssessmentDelegationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, assessmentDelegationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ASSESSMENT-ID%[2]sDELEGATION-ID", id, assessmentDelegationResourceIDSeparator)
+}
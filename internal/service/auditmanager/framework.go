@@ -0,0 +1,379 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFramework() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFrameworkCreate,
+		ReadWithoutTimeout:   resourceFrameworkRead,
+		UpdateWithoutTimeout: resourceFrameworkUpdate,
+		DeleteWithoutTimeout: resourceFrameworkDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compliance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"control_sets": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"controls": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 300),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"framework_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateAssessmentFrameworkInput{
+		ControlSets: expandCreateAssessmentFrameworkControlSets(d.Get("control_sets").([]interface{})),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("compliance_type"); ok {
+		input.ComplianceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating Audit Manager Framework: %s", input)
+	output, err := conn.CreateAssessmentFrameworkWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Audit Manager Framework (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Framework.Id))
+
+	return resourceFrameworkRead(ctx, d, meta)
+}
+
+func resourceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	framework, err := FindFrameworkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Framework (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Audit Manager Framework (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", framework.Arn)
+	d.Set("compliance_type", framework.ComplianceType)
+	if err := d.Set("control_sets", flattenFrameworkControlSets(framework.ControlSets)); err != nil {
+		return diag.Errorf("setting control_sets: %s", err)
+	}
+	d.Set("description", framework.Description)
+	d.Set("framework_type", framework.Type)
+	d.Set("name", framework.Name)
+
+	tags := KeyValueTags(framework.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateAssessmentFrameworkInput{
+			ControlSets: expandUpdateAssessmentFrameworkControlSets(d.Get("control_sets").([]interface{})),
+			Description: aws.String(d.Get("description").(string)),
+			FrameworkId: aws.String(d.Id()),
+			Name:        aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("compliance_type"); ok {
+			input.ComplianceType = aws.String(v.(string))
+		}
+
+		log.Printf("[INFO] Updating Audit Manager Framework: %s", input)
+		_, err := conn.UpdateAssessmentFrameworkWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Audit Manager Framework (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Audit Manager Framework (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFrameworkRead(ctx, d, meta)
+}
+
+func resourceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[INFO] Deleting Audit Manager Framework: %s", d.Id())
+	_, err := conn.DeleteAssessmentFrameworkWithContext(ctx, &auditmanager.DeleteAssessmentFrameworkInput{
+		FrameworkId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Audit Manager Framework (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindFrameworkByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Framework, error) {
+	input := &auditmanager.GetAssessmentFrameworkInput{
+		FrameworkId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentFrameworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Framework, nil
+}
+
+func expandCreateAssessmentFrameworkControls(tfList []interface{}) []*auditmanager.CreateAssessmentFrameworkControl {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateAssessmentFrameworkControl
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateAssessmentFrameworkControl{}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandCreateAssessmentFrameworkControlSets(tfList []interface{}) []*auditmanager.CreateAssessmentFrameworkControlSet {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.CreateAssessmentFrameworkControlSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateAssessmentFrameworkControlSet{}
+
+		if v, ok := tfMap["controls"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Controls = expandCreateAssessmentFrameworkControls(v.List())
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandUpdateAssessmentFrameworkControlSets(tfList []interface{}) []*auditmanager.UpdateAssessmentFrameworkControlSet {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*auditmanager.UpdateAssessmentFrameworkControlSet
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.UpdateAssessmentFrameworkControlSet{}
+
+		if v, ok := tfMap["controls"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Controls = expandCreateAssessmentFrameworkControls(v.List())
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFrameworkControlSets(apiObjects []*auditmanager.ControlSet) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Controls; v != nil {
+			var controls []interface{}
+
+			for _, control := range v {
+				if control == nil {
+					continue
+				}
+
+				controls = append(controls, map[string]interface{}{
+					"id": aws.StringValue(control.Id),
+				})
+			}
+
+			tfMap["controls"] = controls
+		}
+
+		if v := apiObject.Id; v != nil {
+			tfMap["id"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
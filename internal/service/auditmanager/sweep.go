@@ -0,0 +1,171 @@
+//go:build sweep
+// +build sweep
+
+package auditmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_auditmanager_assessment", &resource.Sweeper{
+		Name: "aws_auditmanager_assessment",
+		F:    sweepAssessments,
+	})
+
+	resource.AddTestSweepers("aws_auditmanager_control", &resource.Sweeper{
+		Name: "aws_auditmanager_control",
+		F:    sweepControls,
+		Dependencies: []string{
+			"aws_auditmanager_framework",
+		},
+	})
+
+	resource.AddTestSweepers("aws_auditmanager_framework", &resource.Sweeper{
+		Name: "aws_auditmanager_framework",
+		F:    sweepFrameworks,
+		Dependencies: []string{
+			"aws_auditmanager_assessment",
+		},
+	})
+}
+
+func sweepAssessments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	input := &auditmanager.ListAssessmentsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListAssessmentsPages(input, func(page *auditmanager.ListAssessmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssessmentMetadata {
+			r := ResourceAssessment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Assessment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Assessments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Assessments (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepControls(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	input := &auditmanager.ListControlsInput{
+		ControlType: aws.String(auditmanager.ControlTypeCustom),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListControlsPages(input, func(page *auditmanager.ListControlsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ControlMetadataList {
+			r := ResourceControl()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Control sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Controls (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Controls (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepFrameworks(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).AuditManagerConn
+	input := &auditmanager.ListAssessmentFrameworksInput{
+		FrameworkType: aws.String(auditmanager.FrameworkTypeCustom),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListAssessmentFrameworksPages(input, func(page *auditmanager.ListAssessmentFrameworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FrameworkMetadataList {
+			r := ResourceFramework()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Audit Manager Framework sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Audit Manager Frameworks (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Audit Manager Frameworks (%s): %w", region, err)
+	}
+
+	return nil
+}
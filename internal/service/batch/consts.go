@@ -3,3 +3,31 @@ package batch
 const (
 	jobDefinitionStatusInactive = "INACTIVE"
 )
+
+const (
+	dnsPolicyClusterFirst            = "ClusterFirst"
+	dnsPolicyClusterFirstWithHostNet = "ClusterFirstWithHostNet"
+	dnsPolicyDefault                 = "Default"
+)
+
+func dnsPolicy_Values() []string {
+	return []string{
+		dnsPolicyClusterFirst,
+		dnsPolicyClusterFirstWithHostNet,
+		dnsPolicyDefault,
+	}
+}
+
+const (
+	imagePullPolicyAlways       = "Always"
+	imagePullPolicyIfNotPresent = "IfNotPresent"
+	imagePullPolicyNever        = "Never"
+)
+
+func imagePullPolicy_Values() []string {
+	return []string{
+		imagePullPolicyAlways,
+		imagePullPolicyIfNotPresent,
+		imagePullPolicyNever,
+	}
+}
@@ -0,0 +1,133 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/batch"
+)
+
+type ecsProperties batch.EcsProperties
+
+func (ep *ecsProperties) Reduce() error {
+	for _, taskProps := range ep.TaskProperties {
+		if taskProps == nil {
+			continue
+		}
+
+		// Prevent difference of API response that contains the default Fargate platform version
+		if aws.StringValue(taskProps.PlatformVersion) == "LATEST" {
+			taskProps.PlatformVersion = nil
+		}
+
+		// Prevent difference of API response that adds an empty array when not configured during the request
+		if len(taskProps.Volumes) == 0 {
+			taskProps.Volumes = nil
+		}
+
+		for _, container := range taskProps.Containers {
+			if container == nil {
+				continue
+			}
+
+			// Deal with Environment objects which may be re-ordered in the API
+			sort.Slice(container.Environment, func(i, j int) bool {
+				return aws.StringValue(container.Environment[i].Name) < aws.StringValue(container.Environment[j].Name)
+			})
+
+			// Prevent difference of API response that adds an empty array when not configured during the request
+			if len(container.Command) == 0 {
+				container.Command = nil
+			}
+
+			if len(container.DependsOn) == 0 {
+				container.DependsOn = nil
+			}
+
+			if len(container.Environment) == 0 {
+				container.Environment = nil
+			}
+
+			if len(container.MountPoints) == 0 {
+				container.MountPoints = nil
+			}
+
+			if len(container.ResourceRequirements) == 0 {
+				container.ResourceRequirements = nil
+			}
+
+			if len(container.Secrets) == 0 {
+				container.Secrets = nil
+			}
+
+			if len(container.Ulimits) == 0 {
+				container.Ulimits = nil
+			}
+
+			if container.LogConfiguration != nil {
+				if len(container.LogConfiguration.Options) == 0 {
+					container.LogConfiguration.Options = nil
+				}
+
+				if len(container.LogConfiguration.SecretOptions) == 0 {
+					container.LogConfiguration.SecretOptions = nil
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EquivalentECSPropertiesJSON determines equality between two Batch EcsProperties JSON strings
+func EquivalentECSPropertiesJSON(str1, str2 string) (bool, error) {
+	if str1 == "" {
+		str1 = "{}"
+	}
+
+	if str2 == "" {
+		str2 = "{}"
+	}
+
+	var ep1, ep2 ecsProperties
+
+	if err := json.Unmarshal([]byte(str1), &ep1); err != nil {
+		return false, err
+	}
+
+	if err := ep1.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson1, err := jsonutil.BuildJSON(ep1)
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(str2), &ep2); err != nil {
+		return false, err
+	}
+
+	if err := ep2.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := jsonutil.BuildJSON(ep2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJson1, canonicalJson2)
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical Batch ECS Properties JSON are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
@@ -0,0 +1,152 @@
+package batch_test
+
+import (
+	"testing"
+
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+)
+
+func TestEquivalentBatchECSPropertiesJSON(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ApiJson           string
+		ConfigurationJson string
+		ExpectEquivalent  bool
+		ExpectError       bool
+	}{
+		{
+			Name:              "empty",
+			ApiJson:           ``,
+			ConfigurationJson: ``,
+			ExpectEquivalent:  true,
+		},
+		{
+			Name: "reordered Environment and empty arrays",
+			ApiJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"command": ["sleep", "60"],
+					"dependsOn": [],
+					"environment": [
+						{
+							"name": "VARNAME1",
+							"value": "VARVAL1"
+						},
+						{
+							"name": "VARNAME2",
+							"value": "VARVAL2"
+						}
+					],
+					"essential": true,
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:1",
+					"mountPoints": [],
+					"name": "container_a",
+					"resourceRequirements": [
+						{
+							"type": "VCPU",
+							"value": "1"
+						}
+					],
+					"secrets": [],
+					"ulimits": []
+				}
+			],
+			"platformVersion": "LATEST",
+			"volumes": []
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"command": ["sleep", "60"],
+					"environment": [
+						{
+							"name": "VARNAME2",
+							"value": "VARVAL2"
+						},
+						{
+							"name": "VARNAME1",
+							"value": "VARVAL1"
+						}
+					],
+					"essential": true,
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:1",
+					"name": "container_a",
+					"resourceRequirements": [
+						{
+							"type": "VCPU",
+							"value": "1"
+						}
+					]
+				}
+			]
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "different containers",
+			ApiJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:1",
+					"name": "container_a"
+				}
+			]
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"taskProperties": [
+		{
+			"containers": [
+				{
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:1",
+					"name": "container_a"
+				},
+				{
+					"image": "public.ecr.aws/amazonlinux/amazonlinux:1",
+					"name": "container_b"
+				}
+			]
+		}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := tfbatch.EquivalentECSPropertiesJSON(testCase.ConfigurationJson, testCase.ApiJson)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
@@ -50,7 +50,183 @@ func ResourceJobDefinition() *schema.Resource {
 
 					return equal
 				},
-				ValidateFunc: validJobContainerProperties,
+				ValidateFunc:  validJobContainerProperties,
+				ConflictsWith: []string{"ecs_properties", "eks_properties"},
+			},
+			"ecs_properties": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := EquivalentECSPropertiesJSON(old, new)
+
+					return equal
+				},
+				ValidateFunc:  validJobECSProperties,
+				ConflictsWith: []string{"container_properties", "eks_properties"},
+			},
+			"eks_properties": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"container_properties", "ecs_properties"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_properties": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"containers": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MinItems: 1,
+										MaxItems: 10,
+										Elem:     eksContainerSchema(),
+									},
+									"dns_policy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(dnsPolicy_Values(), false),
+									},
+									"host_network": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  true,
+									},
+									"image_pull_secret": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"init_containers": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 10,
+										Elem:     eksContainerSchema(),
+									},
+									"metadata": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"labels": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"service_account_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"share_process_namespace": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"volumes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"empty_dir": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"medium": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringInSlice([]string{"", "Memory"}, false),
+															},
+															"size_limit": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"host_path": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"secret": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"optional": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"secret_name": {
+																Type:     schema.TypeString,
+																Required: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 			"parameters": {
 				Type:     schema.TypeMap,
@@ -174,6 +350,146 @@ func ResourceJobDefinition() *schema.Resource {
 	}
 }
 
+func eksContainerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"args": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"env": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"image_pull_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(imagePullPolicy_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"limits": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"requests": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"security_context": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_privilege_escalation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"privileged": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"read_only_root_file_system": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"run_as_group": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"run_as_non_root": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"run_as_user": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"volume_mounts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mount_path": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"read_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).BatchConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -195,6 +511,19 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 		input.ContainerProperties = props
 	}
 
+	if v, ok := d.GetOk("ecs_properties"); ok {
+		props, err := expandBatchJobECSProperties(v.(string))
+		if err != nil {
+			return err
+		}
+
+		input.EcsProperties = props
+	}
+
+	if v, ok := d.GetOk("eks_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EksProperties = expandBatchEKSProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
 	}
@@ -255,6 +584,24 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting container_properties: %w", err)
 	}
 
+	ecsProperties, err := flattenBatchECSProperties(jobDefinition.EcsProperties)
+
+	if err != nil {
+		return fmt.Errorf("error converting Batch ECS Properties to JSON: %w", err)
+	}
+
+	if err := d.Set("ecs_properties", ecsProperties); err != nil {
+		return fmt.Errorf("error setting ecs_properties: %w", err)
+	}
+
+	if jobDefinition.EksProperties != nil {
+		if err := d.Set("eks_properties", []interface{}{flattenBatchEKSProperties(jobDefinition.EksProperties)}); err != nil {
+			return fmt.Errorf("error setting eks_properties: %w", err)
+		}
+	} else {
+		d.Set("eks_properties", nil)
+	}
+
 	d.Set("name", jobDefinition.JobDefinitionName)
 	d.Set("parameters", aws.StringValueMap(jobDefinition.Parameters))
 	d.Set("platform_capabilities", aws.StringValueSlice(jobDefinition.PlatformCapabilities))
@@ -352,6 +699,37 @@ func flattenBatchContainerProperties(containerProperties *batch.ContainerPropert
 	return string(b), nil
 }
 
+func validJobECSProperties(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	_, err := expandBatchJobECSProperties(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("AWS Batch Job ecs_properties is invalid: %s", err))
+	}
+	return
+}
+
+func expandBatchJobECSProperties(rawProps string) (*batch.EcsProperties, error) {
+	var props *batch.EcsProperties
+
+	err := json.Unmarshal([]byte(rawProps), &props)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding JSON: %s", err)
+	}
+
+	return props, nil
+}
+
+// Convert batch.EcsProperties object into its JSON representation
+func flattenBatchECSProperties(ecsProperties *batch.EcsProperties) (string, error) {
+	b, err := jsonutil.BuildJSON(ecsProperties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 func expandJobDefinitionParameters(params map[string]interface{}) map[string]*string {
 	var jobParams = make(map[string]*string)
 	for k, v := range params {
@@ -520,3 +898,537 @@ func flattenBatchJobTimeout(apiObject *batch.JobTimeout) map[string]interface{}
 
 	return tfMap
 }
+
+func expandBatchEKSProperties(tfMap map[string]interface{}) *batch.EksProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksProperties{}
+
+	if v, ok := tfMap["pod_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PodProperties = expandBatchEKSPodProperties(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandBatchEKSPodProperties(tfMap map[string]interface{}) *batch.EksPodProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksPodProperties{}
+
+	if v, ok := tfMap["containers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Containers = expandBatchEKSContainers(v)
+	}
+
+	if v, ok := tfMap["dns_policy"].(string); ok && v != "" {
+		apiObject.DnsPolicy = aws.String(v)
+	}
+
+	if v, ok := tfMap["host_network"].(bool); ok {
+		apiObject.HostNetwork = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["image_pull_secret"].([]interface{}); ok && len(v) > 0 {
+		apiObject.ImagePullSecrets = expandBatchImagePullSecrets(v)
+	}
+
+	if v, ok := tfMap["init_containers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.InitContainers = expandBatchEKSContainers(v)
+	}
+
+	if v, ok := tfMap["metadata"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Metadata = &batch.EksMetadata{
+				Labels: flex.ExpandStringMap(v),
+			}
+		}
+	}
+
+	if v, ok := tfMap["service_account_name"].(string); ok && v != "" {
+		apiObject.ServiceAccountName = aws.String(v)
+	}
+
+	if v, ok := tfMap["share_process_namespace"].(bool); ok && v {
+		apiObject.ShareProcessNamespace = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["volumes"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Volumes = expandBatchEKSVolumes(v)
+	}
+
+	return apiObject
+}
+
+func expandBatchEKSContainer(tfMap map[string]interface{}) *batch.EksContainer {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksContainer{}
+
+	if v, ok := tfMap["args"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Args = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["env"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			env := &batch.EksContainerEnvironmentVariable{
+				Name: aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				env.Value = aws.String(v)
+			}
+
+			apiObject.Env = append(apiObject.Env, env)
+		}
+	}
+
+	if v, ok := tfMap["image"].(string); ok && v != "" {
+		apiObject.Image = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_pull_policy"].(string); ok && v != "" {
+		apiObject.ImagePullPolicy = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["resources"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		resources := &batch.EksContainerResourceRequirements{}
+
+		if v, ok := tfMap["limits"].(map[string]interface{}); ok && len(v) > 0 {
+			resources.Limits = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["requests"].(map[string]interface{}); ok && len(v) > 0 {
+			resources.Requests = flex.ExpandStringMap(v)
+		}
+
+		apiObject.Resources = resources
+	}
+
+	if v, ok := tfMap["security_context"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		securityContext := &batch.EksContainerSecurityContext{}
+
+		if v, ok := tfMap["allow_privilege_escalation"].(bool); ok && v {
+			securityContext.AllowPrivilegeEscalation = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			securityContext.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["read_only_root_file_system"].(bool); ok && v {
+			securityContext.ReadOnlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["run_as_group"].(int); ok && v != 0 {
+			securityContext.RunAsGroup = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["run_as_non_root"].(bool); ok && v {
+			securityContext.RunAsNonRoot = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["run_as_user"].(int); ok && v != 0 {
+			securityContext.RunAsUser = aws.Int64(int64(v))
+		}
+
+		apiObject.SecurityContext = securityContext
+	}
+
+	if v, ok := tfMap["volume_mounts"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			volumeMount := &batch.EksContainerVolumeMount{
+				MountPath: aws.String(tfMap["mount_path"].(string)),
+				Name:      aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["read_only"].(bool); ok && v {
+				volumeMount.ReadOnly = aws.Bool(v)
+			}
+
+			apiObject.VolumeMounts = append(apiObject.VolumeMounts, volumeMount)
+		}
+	}
+
+	return apiObject
+}
+
+func expandBatchEKSContainers(tfList []interface{}) []*batch.EksContainer {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksContainer
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandBatchEKSContainer(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBatchImagePullSecrets(tfList []interface{}) []*batch.ImagePullSecret {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.ImagePullSecret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &batch.ImagePullSecret{
+			Name: aws.String(tfMap["name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandBatchEKSVolume(tfMap map[string]interface{}) *batch.EksVolume {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.EksVolume{}
+
+	if v, ok := tfMap["empty_dir"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		emptyDir := &batch.EksEmptyDir{}
+
+		if v, ok := tfMap["medium"].(string); ok && v != "" {
+			emptyDir.Medium = aws.String(v)
+		}
+
+		if v, ok := tfMap["size_limit"].(string); ok && v != "" {
+			emptyDir.SizeLimit = aws.String(v)
+		}
+
+		apiObject.EmptyDir = emptyDir
+	}
+
+	if v, ok := tfMap["host_path"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.HostPath = &batch.EksHostPath{
+			Path: aws.String(tfMap["path"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		secret := &batch.EksSecret{
+			SecretName: aws.String(tfMap["secret_name"].(string)),
+		}
+
+		if v, ok := tfMap["optional"].(bool); ok && v {
+			secret.Optional = aws.Bool(v)
+		}
+
+		apiObject.Secret = secret
+	}
+
+	return apiObject
+}
+
+func expandBatchEKSVolumes(tfList []interface{}) []*batch.EksVolume {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*batch.EksVolume
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandBatchEKSVolume(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBatchEKSProperties(apiObject *batch.EksProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PodProperties; v != nil {
+		tfMap["pod_properties"] = []interface{}{flattenBatchEKSPodProperties(v)}
+	}
+
+	return tfMap
+}
+
+func flattenBatchEKSPodProperties(apiObject *batch.EksPodProperties) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Containers; v != nil {
+		tfMap["containers"] = flattenBatchEKSContainers(v)
+	}
+
+	if v := apiObject.DnsPolicy; v != nil {
+		tfMap["dns_policy"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HostNetwork; v != nil {
+		tfMap["host_network"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.ImagePullSecrets; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"name": aws.StringValue(apiObject.Name),
+			})
+		}
+
+		tfMap["image_pull_secret"] = tfList
+	}
+
+	if v := apiObject.InitContainers; v != nil {
+		tfMap["init_containers"] = flattenBatchEKSContainers(v)
+	}
+
+	if v := apiObject.Metadata; v != nil && len(v.Labels) > 0 {
+		tfMap["metadata"] = []interface{}{map[string]interface{}{
+			"labels": aws.StringValueMap(v.Labels),
+		}}
+	}
+
+	if v := apiObject.ServiceAccountName; v != nil {
+		tfMap["service_account_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ShareProcessNamespace; v != nil {
+		tfMap["share_process_namespace"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Volumes; v != nil {
+		tfMap["volumes"] = flattenBatchEKSVolumes(v)
+	}
+
+	return tfMap
+}
+
+func flattenBatchEKSContainer(apiObject *batch.EksContainer) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Args; v != nil {
+		tfMap["args"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Command; v != nil {
+		tfMap["command"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.Env; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.StringValue(apiObject.Name),
+				"value": aws.StringValue(apiObject.Value),
+			})
+		}
+
+		tfMap["env"] = tfList
+	}
+
+	if v := apiObject.Image; v != nil {
+		tfMap["image"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ImagePullPolicy; v != nil {
+		tfMap["image_pull_policy"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Resources; v != nil && (len(v.Limits) > 0 || len(v.Requests) > 0) {
+		tfMap["resources"] = []interface{}{map[string]interface{}{
+			"limits":   aws.StringValueMap(v.Limits),
+			"requests": aws.StringValueMap(v.Requests),
+		}}
+	}
+
+	if v := apiObject.SecurityContext; v != nil {
+		tfMap["security_context"] = []interface{}{map[string]interface{}{
+			"allow_privilege_escalation": aws.BoolValue(v.AllowPrivilegeEscalation),
+			"privileged":                 aws.BoolValue(v.Privileged),
+			"read_only_root_file_system": aws.BoolValue(v.ReadOnlyRootFilesystem),
+			"run_as_group":               aws.Int64Value(v.RunAsGroup),
+			"run_as_non_root":            aws.BoolValue(v.RunAsNonRoot),
+			"run_as_user":                aws.Int64Value(v.RunAsUser),
+		}}
+	}
+
+	if v := apiObject.VolumeMounts; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			if apiObject == nil {
+				continue
+			}
+
+			tfList = append(tfList, map[string]interface{}{
+				"mount_path": aws.StringValue(apiObject.MountPath),
+				"name":       aws.StringValue(apiObject.Name),
+				"read_only":  aws.BoolValue(apiObject.ReadOnly),
+			})
+		}
+
+		tfMap["volume_mounts"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenBatchEKSContainers(apiObjects []*batch.EksContainer) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenBatchEKSContainer(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenBatchEKSVolume(apiObject *batch.EksVolume) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EmptyDir; v != nil {
+		tfMap["empty_dir"] = []interface{}{map[string]interface{}{
+			"medium":     aws.StringValue(v.Medium),
+			"size_limit": aws.StringValue(v.SizeLimit),
+		}}
+	}
+
+	if v := apiObject.HostPath; v != nil {
+		tfMap["host_path"] = []interface{}{map[string]interface{}{
+			"path": aws.StringValue(v.Path),
+		}}
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Secret; v != nil {
+		tfMap["secret"] = []interface{}{map[string]interface{}{
+			"optional":    aws.BoolValue(v.Optional),
+			"secret_name": aws.StringValue(v.SecretName),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenBatchEKSVolumes(apiObjects []*batch.EksVolume) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenBatchEKSVolume(apiObject))
+	}
+
+	return tfList
+}
@@ -369,6 +369,77 @@ func TestAccBatchJobDefinition_propagateTags(t *testing.T) {
 	})
 }
 
+func TestAccBatchJobDefinition_ECSProperties_multiContainer(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, batch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobDefinitionConfigECSPropertiesMultiContainer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "ecs_properties"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBatchJobDefinition_EKSProperties_basic(t *testing.T) {
+	var jd batch.JobDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, batch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBatchJobDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchJobDefinitionConfigEKSProperties(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBatchJobDefinitionExists(resourceName, &jd),
+					resource.TestCheckResourceAttr(resourceName, "container_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "ecs_properties", ""),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image", "public.ecr.aws/amazonlinux/amazonlinux:1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.image_pull_policy", "Always"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.resources.0.limits.cpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.containers.0.volume_mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.host_network", "true"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.metadata.0.labels.environment", "test"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "eks_properties.0.pod_properties.0.volumes.0.name", "tmp"),
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBatchJobDefinitionExists(n string, jd *batch.JobDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -749,3 +820,87 @@ resource "aws_batch_job_definition" "test" {
 }
 `, rName)
 }
+
+func testAccBatchJobDefinitionConfigECSPropertiesMultiContainer(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  ecs_properties = jsonencode({
+    taskProperties = [{
+      containers = [
+        {
+          image     = "public.ecr.aws/amazonlinux/amazonlinux:1"
+          command   = ["sleep", "60"]
+          dependsOn = [{ containerName = "container_b", condition = "COMPLETE" }]
+          name      = "container_a"
+          resourceRequirements = [
+            { type = "VCPU", value = "1" },
+            { type = "MEMORY", value = "2048" },
+          ]
+        },
+        {
+          image     = "public.ecr.aws/amazonlinux/amazonlinux:1"
+          command   = ["sleep", "360"]
+          essential = false
+          name      = "container_b"
+          resourceRequirements = [
+            { type = "VCPU", value = "1" },
+            { type = "MEMORY", value = "2048" },
+          ]
+        },
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccBatchJobDefinitionConfigEKSProperties(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_job_definition" "test" {
+  name = %[1]q
+  type = "container"
+
+  eks_properties {
+    pod_properties {
+      host_network = true
+
+      containers {
+        image             = "public.ecr.aws/amazonlinux/amazonlinux:1"
+        image_pull_policy = "Always"
+        command           = ["sleep", "60"]
+
+        resources {
+          limits = {
+            cpu    = "1"
+            memory = "1024Mi"
+          }
+        }
+
+        volume_mounts {
+          mount_path = "/tmp"
+          name       = "tmp"
+        }
+      }
+
+      metadata {
+        labels = {
+          environment = "test"
+        }
+      }
+
+      volumes {
+        name = "tmp"
+
+        empty_dir {
+          medium     = "Memory"
+          size_limit = "128Mi"
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package chime
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_chime_voice_connector", &resource.Sweeper{
+		Name: "aws_chime_voice_connector",
+		F:    sweepVoiceConnectors,
+		Dependencies: []string{
+			"aws_chime_voice_connector_group",
+		},
+	})
+
+	resource.AddTestSweepers("aws_chime_voice_connector_group", &resource.Sweeper{
+		Name: "aws_chime_voice_connector_group",
+		F:    sweepVoiceConnectorGroups,
+	})
+}
+
+func sweepVoiceConnectors(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ChimeConn
+	input := &chime.ListVoiceConnectorsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListVoiceConnectorsPages(input, func(page *chime.ListVoiceConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VoiceConnectors {
+			r := ResourceVoiceConnector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VoiceConnectorId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Chime Voice Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Chime Voice Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Chime Voice Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepVoiceConnectorGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ChimeConn
+	input := &chime.ListVoiceConnectorGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListVoiceConnectorGroupsPages(input, func(page *chime.ListVoiceConnectorGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VoiceConnectorGroups {
+			r := ResourceVoiceConnectorGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.VoiceConnectorGroupId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Chime Voice Connector Group sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Chime Voice Connector Groups (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Chime Voice Connector Groups (%s): %w", region, err)
+	}
+
+	return nil
+}
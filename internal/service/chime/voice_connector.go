@@ -126,7 +126,7 @@ func resourceVoiceConnectorDelete(ctx context.Context, d *schema.ResourceData, m
 			log.Printf("[WARN] Chime Voice connector %s not found", d.Id())
 			return nil
 		}
-		return diag.Errorf("Error deleting Voice connector (%s)", d.Id())
+		return diag.Errorf("Error deleting Voice connector (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -0,0 +1,534 @@
+package cloudformation
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceStackInstances() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStackInstancesCreate,
+		Read:   resourceStackInstancesRead,
+		Update: resourceStackInstancesUpdate,
+		Delete: resourceStackInstancesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(StackSetInstanceCreatedDefaultTimeout),
+			Update: schema.DefaultTimeout(StackSetInstanceUpdatedDefaultTimeout),
+			Delete: schema.DefaultTimeout(StackSetInstanceDeletedDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				ConflictsWith: []string{"deployment_targets"},
+			},
+			"call_as": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudformation.CallAsSelf,
+				ValidateFunc: validation.StringInSlice(cloudformation.CallAs_Values(), false),
+			},
+			"deployment_targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), ""),
+							},
+						},
+					},
+				},
+				ConflictsWith: []string{"accounts"},
+			},
+			"operation_preferences": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_tolerance_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(0),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+						},
+						"failure_tolerance_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(0, 100),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+						},
+						"max_concurrent_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+						},
+						"max_concurrent_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+						},
+						"region_concurrency_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.RegionConcurrencyType_Values(), false),
+						},
+						"region_order": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), ""),
+							},
+						},
+					},
+				},
+			},
+			"parameter_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]{1,128}$`), ""),
+				},
+			},
+			"retain_stacks": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"stack_instance_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"detailed_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organizational_unit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_set_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceStackInstancesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	stackSetName := d.Get("stack_set_name").(string)
+	callAs := d.Get("call_as").(string)
+
+	regions := flex.ExpandStringSet(d.Get("regions").(*schema.Set))
+	if len(regions) == 0 {
+		regions = aws.StringSlice([]string{meta.(*conns.AWSClient).Region})
+	}
+
+	targets := stackInstancesTargets(d)
+	if len(targets) == 0 {
+		targets = aws.StringSlice([]string{meta.(*conns.AWSClient).AccountID})
+	}
+
+	operationID, err := createStackInstances(conn, d, targets, regions)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFormation Stack Instances (%s): %w", stackSetName, err)
+	}
+
+	// Track the instances that were deployed before waiting so that a failed operation
+	// leaves the resource tainted rather than orphaning any successfully deployed stacks.
+	d.SetId(stackSetName)
+	d.Set("regions", aws.StringValueSlice(regions))
+	if !stackInstancesUseDeploymentTargets(d) {
+		d.Set("accounts", aws.StringValueSlice(targets))
+	}
+
+	if _, err := WaitStackSetOperationSucceeded(conn, stackSetName, operationID, callAs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation Stack Instances (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceStackInstancesRead(d, meta)
+}
+
+func resourceStackInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	callAs := d.Get("call_as").(string)
+
+	summaries, err := FindStackInstanceSummariesByName(conn, d.Id(), callAs)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudFormation Stack Instances (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Stack Instances (%s): %w", d.Id(), err)
+	}
+
+	regions := d.Get("regions").(*schema.Set)
+	accounts := d.Get("accounts").(*schema.Set)
+	useDeploymentTargets := stackInstancesUseDeploymentTargets(d)
+	var organizationalUnitIDs *schema.Set
+	if useDeploymentTargets {
+		organizationalUnitIDs = d.Get("deployment_targets.0.organizational_unit_ids").(*schema.Set)
+	}
+
+	var instances []*cloudformation.StackInstanceSummary
+
+	for _, v := range summaries {
+		if regions.Len() > 0 && !regions.Contains(aws.StringValue(v.Region)) {
+			continue
+		}
+
+		if useDeploymentTargets {
+			if !organizationalUnitIDs.Contains(aws.StringValue(v.OrganizationalUnitId)) {
+				continue
+			}
+		} else if accounts.Len() > 0 && !accounts.Contains(aws.StringValue(v.Account)) {
+			continue
+		}
+
+		if status := aws.StringValue(v.Status); status == cloudformation.StackInstanceStatusInoperable {
+			log.Printf("[WARN] CloudFormation Stack Instance (%s,%s,%s) is %s: %s", d.Id(), aws.StringValue(v.Account), aws.StringValue(v.Region), status, aws.StringValue(v.StatusReason))
+		}
+
+		instances = append(instances, v)
+	}
+
+	if !d.IsNewResource() && len(instances) == 0 {
+		log.Printf("[WARN] CloudFormation Stack Instances (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	var instanceAccounts, instanceRegions []string
+	for _, v := range instances {
+		instanceAccounts = append(instanceAccounts, aws.StringValue(v.Account))
+		instanceRegions = append(instanceRegions, aws.StringValue(v.Region))
+	}
+
+	d.Set("accounts", instanceAccounts)
+	d.Set("regions", instanceRegions)
+	if err := d.Set("stack_instance_summaries", flattenStackInstanceSummaries(instances)); err != nil {
+		return fmt.Errorf("error setting stack_instance_summaries: %w", err)
+	}
+	d.Set("stack_set_name", d.Id())
+
+	return nil
+}
+
+func resourceStackInstancesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	callAs := d.Get("call_as").(string)
+
+	o, n := d.GetChange("regions")
+	oldRegions, newRegions := o.(*schema.Set), n.(*schema.Set)
+	removedRegions := flex.ExpandStringSet(oldRegions.Difference(newRegions))
+	addedRegions := flex.ExpandStringSet(newRegions.Difference(oldRegions))
+	keptRegions := flex.ExpandStringSet(oldRegions.Intersection(newRegions))
+
+	// Organizational unit targets cannot change in-place, so only account targets are diffed.
+	var removedTargets, addedTargets, keptTargets []*string
+	if stackInstancesUseDeploymentTargets(d) {
+		keptTargets = stackInstancesTargets(d)
+	} else {
+		o, n := d.GetChange("accounts")
+		oldAccounts, newAccounts := o.(*schema.Set), n.(*schema.Set)
+		removedTargets = flex.ExpandStringSet(oldAccounts.Difference(newAccounts))
+		addedTargets = flex.ExpandStringSet(newAccounts.Difference(oldAccounts))
+		keptTargets = flex.ExpandStringSet(oldAccounts.Intersection(newAccounts))
+	}
+
+	type stackInstancesOperation struct {
+		targets []*string
+		regions []*string
+	}
+
+	for _, v := range []stackInstancesOperation{
+		{targets: removedTargets, regions: flex.ExpandStringSet(oldRegions)},
+		{targets: keptTargets, regions: removedRegions},
+	} {
+		if len(v.targets) == 0 || len(v.regions) == 0 {
+			continue
+		}
+
+		operationID, err := deleteStackInstances(conn, d, v.targets, v.regions)
+
+		if err != nil {
+			return fmt.Errorf("error deleting CloudFormation Stack Instances (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitStackSetOperationSucceeded(conn, d.Id(), operationID, callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for CloudFormation Stack Instances (%s) delete: %w", d.Id(), err)
+		}
+	}
+
+	for _, v := range []stackInstancesOperation{
+		{targets: addedTargets, regions: flex.ExpandStringSet(newRegions)},
+		{targets: keptTargets, regions: addedRegions},
+	} {
+		if len(v.targets) == 0 || len(v.regions) == 0 {
+			continue
+		}
+
+		operationID, err := createStackInstances(conn, d, v.targets, v.regions)
+
+		if err != nil {
+			return fmt.Errorf("error creating CloudFormation Stack Instances (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitStackSetOperationSucceeded(conn, d.Id(), operationID, callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for CloudFormation Stack Instances (%s) create: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("parameter_overrides") && len(keptTargets) > 0 && len(keptRegions) > 0 {
+		input := &cloudformation.UpdateStackInstancesInput{
+			CallAs:             aws.String(callAs),
+			OperationId:        aws.String(resource.UniqueId()),
+			ParameterOverrides: []*cloudformation.Parameter{},
+			Regions:            keptRegions,
+			StackSetName:       aws.String(d.Id()),
+		}
+
+		if stackInstancesUseDeploymentTargets(d) {
+			input.DeploymentTargets = &cloudformation.DeploymentTargets{OrganizationalUnitIds: keptTargets}
+		} else {
+			input.Accounts = keptTargets
+		}
+
+		if v, ok := d.GetOk("parameter_overrides"); ok {
+			input.ParameterOverrides = expandParameters(v.(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating CloudFormation Stack Instances: %s", input)
+		output, err := conn.UpdateStackInstances(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating CloudFormation Stack Instances (%s): %w", d.Id(), err)
+		}
+
+		if _, err := WaitStackSetOperationSucceeded(conn, d.Id(), aws.StringValue(output.OperationId), callAs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for CloudFormation Stack Instances (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceStackInstancesRead(d, meta)
+}
+
+func resourceStackInstancesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFormationConn
+
+	targets := stackInstancesTargets(d)
+	regions := flex.ExpandStringSet(d.Get("regions").(*schema.Set))
+
+	if len(targets) == 0 || len(regions) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack Instances: %s", d.Id())
+	operationID, err := deleteStackInstances(conn, d, targets, regions)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeStackInstanceNotFoundException) || tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeStackSetNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFormation Stack Instances (%s): %w", d.Id(), err)
+	}
+
+	if _, err := WaitStackSetOperationSucceeded(conn, d.Id(), operationID, d.Get("call_as").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for CloudFormation Stack Instances (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func createStackInstances(conn *cloudformation.CloudFormation, d *schema.ResourceData, targets, regions []*string) (string, error) {
+	input := &cloudformation.CreateStackInstancesInput{
+		CallAs:       aws.String(d.Get("call_as").(string)),
+		OperationId:  aws.String(resource.UniqueId()),
+		Regions:      regions,
+		StackSetName: aws.String(d.Get("stack_set_name").(string)),
+	}
+
+	if stackInstancesUseDeploymentTargets(d) {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{OrganizationalUnitIds: targets}
+	} else {
+		input.Accounts = targets
+	}
+
+	if v, ok := d.GetOk("parameter_overrides"); ok {
+		input.ParameterOverrides = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating CloudFormation Stack Instances: %s", input)
+	output, err := conn.CreateStackInstances(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.OperationId), nil
+}
+
+func deleteStackInstances(conn *cloudformation.CloudFormation, d *schema.ResourceData, targets, regions []*string) (string, error) {
+	input := &cloudformation.DeleteStackInstancesInput{
+		CallAs:       aws.String(d.Get("call_as").(string)),
+		OperationId:  aws.String(resource.UniqueId()),
+		Regions:      regions,
+		RetainStacks: aws.Bool(d.Get("retain_stacks").(bool)),
+		StackSetName: aws.String(d.Get("stack_set_name").(string)),
+	}
+
+	if stackInstancesUseDeploymentTargets(d) {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{OrganizationalUnitIds: targets}
+	} else {
+		input.Accounts = targets
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Deleting CloudFormation Stack Instances: %s", input)
+	output, err := conn.DeleteStackInstances(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.OperationId), nil
+}
+
+func stackInstancesUseDeploymentTargets(d *schema.ResourceData) bool {
+	v, ok := d.GetOk("deployment_targets")
+
+	return ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil
+}
+
+// stackInstancesTargets returns the organizational unit IDs when deployment targets are configured, otherwise the account IDs.
+func stackInstancesTargets(d *schema.ResourceData) []*string {
+	if stackInstancesUseDeploymentTargets(d) {
+		return flex.ExpandStringSet(d.Get("deployment_targets.0.organizational_unit_ids").(*schema.Set))
+	}
+
+	return flex.ExpandStringSet(d.Get("accounts").(*schema.Set))
+}
+
+func flattenStackInstanceSummaries(apiObjects []*cloudformation.StackInstanceSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"account_id":             aws.StringValue(apiObject.Account),
+			"drift_status":           aws.StringValue(apiObject.DriftStatus),
+			"organizational_unit_id": aws.StringValue(apiObject.OrganizationalUnitId),
+			"region":                 aws.StringValue(apiObject.Region),
+			"stack_id":               aws.StringValue(apiObject.StackId),
+			"status":                 aws.StringValue(apiObject.Status),
+			"status_reason":          aws.StringValue(apiObject.StatusReason),
+		}
+
+		if v := apiObject.StackInstanceStatus; v != nil {
+			tfMap["detailed_status"] = aws.StringValue(v.DetailedStatus)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
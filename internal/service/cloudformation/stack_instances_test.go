@@ -0,0 +1,253 @@
+package cloudformation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudFormationStackInstances_basic(t *testing.T) {
+	var summaries []*cloudformation.StackInstanceSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	cloudformationStackSetResourceName := "aws_cloudformation_stack_set.test"
+	resourceName := "aws_cloudformation_stack_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackInstancesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries),
+					resource.TestCheckResourceAttr(resourceName, "accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "accounts.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "retain_stacks", "false"),
+					resource.TestCheckResourceAttr(resourceName, "stack_instance_summaries.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "stack_instance_summaries.0.stack_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", cloudformationStackSetResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_stacks",
+					"call_as",
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackInstances_disappears(t *testing.T) {
+	var summaries []*cloudformation.StackInstanceSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackInstancesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudformation.ResourceStackInstances(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackInstances_regions(t *testing.T) {
+	var summaries1, summaries2 []*cloudformation.StackInstanceSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckStackSet(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackInstancesConfig_regions(rName, acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries1),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "stack_instance_summaries.#", "1"),
+				),
+			},
+			{
+				Config: testAccStackInstancesConfig_regions(rName, acctest.Region(), acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries2),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "stack_instance_summaries.#", "2"),
+				),
+			},
+			{
+				Config: testAccStackInstancesConfig_regions(rName, acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries2),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "stack_instance_summaries.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackInstances_parameterOverrides(t *testing.T) {
+	var summaries []*cloudformation.StackInstanceSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_instances.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackInstancesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackInstancesConfig_parameterOverrides(rName, "overridevalue1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.Parameter1", "overridevalue1"),
+				),
+			},
+			{
+				Config: testAccStackInstancesConfig_parameterOverrides(rName, "overridevalue2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackInstancesExists(resourceName, &summaries),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.Parameter1", "overridevalue2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStackInstancesExists(resourceName string, v *[]*cloudformation.StackInstanceSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+
+		output, err := tfcloudformation.FindStackInstanceSummariesByName(conn, rs.Primary.ID, rs.Primary.Attributes["call_as"])
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("CloudFormation Stack Instances (%s) not found", rs.Primary.ID)
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckStackInstancesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_stack_instances" {
+			continue
+		}
+
+		output, err := tfcloudformation.FindStackInstanceSummariesByName(conn, rs.Primary.ID, rs.Primary.Attributes["call_as"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("CloudFormation Stack Instances %s still exist: %s", rs.Primary.ID, aws.StringValue(output[0].StackId))
+		}
+	}
+
+	return nil
+}
+
+func testAccStackInstancesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig(rName), `
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudformation_stack_instances" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`)
+}
+
+func testAccStackInstancesConfig_regions(rName string, regions ...string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_instances" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  regions        = ["%[1]s"]
+  stack_set_name = aws_cloudformation_stack_set.test.name
+
+  operation_preferences {
+    failure_tolerance_count = 1
+    max_concurrent_count    = 2
+  }
+}
+`, strings.Join(regions, `", "`)))
+}
+
+func testAccStackInstancesConfig_parameterOverrides(rName, value1 string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudformation_stack_instances" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  parameter_overrides = {
+    Parameter1 = %[1]q
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, value1))
+}
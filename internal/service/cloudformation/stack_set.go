@@ -1,6 +1,7 @@
 package cloudformation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -92,6 +94,21 @@ func ResourceStackSet() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"auto_deployment"},
 			},
+			"managed_execution": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -181,7 +198,10 @@ func ResourceStackSet() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStackSetCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -216,6 +236,10 @@ func resourceStackSetCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ExecutionRoleName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("managed_execution"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManagedExecution = expandManagedExecution(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandParameters(v.(map[string]interface{}))
 	}
@@ -283,6 +307,11 @@ func resourceStackSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("description", stackSet.Description)
 	d.Set("execution_role_name", stackSet.ExecutionRoleName)
+
+	if err := d.Set("managed_execution", flattenStackSetManagedExecution(stackSet.ManagedExecution)); err != nil {
+		return fmt.Errorf("error setting managed_execution: %w", err)
+	}
+
 	d.Set("name", stackSet.StackSetName)
 	d.Set("permission_model", stackSet.PermissionModel)
 
@@ -336,6 +365,10 @@ func resourceStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.ExecutionRoleName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("managed_execution"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ManagedExecution = expandManagedExecution(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.OperationPreferences = expandOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -439,3 +472,40 @@ func flattenStackSetAutoDeploymentResponse(autoDeployment *cloudformation.AutoDe
 
 	return []map[string]interface{}{m}
 }
+
+func expandManagedExecution(tfMap map[string]interface{}) *cloudformation.ManagedExecution {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.ManagedExecution{}
+
+	if v, ok := tfMap["active"].(bool); ok {
+		apiObject.Active = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenStackSetManagedExecution(apiObject *cloudformation.ManagedExecution) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"active": aws.BoolValue(apiObject.Active),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func resourceStackSetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Automatic deployment is only available to stack sets using the SERVICE_MANAGED permission model.
+	if v, ok := diff.GetOk("auto_deployment"); ok && len(v.([]interface{})) > 0 {
+		if permissionModel := diff.Get("permission_model").(string); permissionModel != cloudformation.PermissionModelsServiceManaged {
+			return fmt.Errorf("auto_deployment requires permission_model to be %s, got %s", cloudformation.PermissionModelsServiceManaged, permissionModel)
+		}
+	}
+
+	return nil
+}
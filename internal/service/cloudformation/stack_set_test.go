@@ -554,6 +554,64 @@ func TestAccCloudFormationStackSet_PermissionModel_serviceManaged(t *testing.T)
 	})
 }
 
+func TestAccCloudFormationStackSet_managedExecution(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetConfig_managedExecution(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "managed_execution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_execution.0.active", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"call_as",
+					"template_url",
+				},
+			},
+			{
+				Config: testAccStackSetConfig_managedExecution(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(resourceName, &stackSet2),
+					testAccCheckStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "managed_execution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_execution.0.active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStackSet_AutoDeployment_selfManaged(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStackSetConfig_autoDeploymentSelfManaged(rName),
+				ExpectError: regexp.MustCompile(`auto_deployment requires permission_model to be SERVICE_MANAGED`),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSet_tags(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1557,6 +1615,62 @@ TEMPLATE
 `, rName, testAccStackSetTemplateBodyVPC(rName))
 }
 
+func testAccStackSetConfig_managedExecution(rName string, active bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "cloudformation.amazonaws.com"
+        ]
+      },
+      "Action": [
+        "sts:AssumeRole"
+      ]
+    }
+  ]
+}
+EOF
+
+  name = %[1]q
+}
+
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = aws_iam_role.test.arn
+  name                    = %[1]q
+
+  managed_execution {
+    active = %[3]t
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccStackSetTemplateBodyVPC(rName), active)
+}
+
+func testAccStackSetConfig_autoDeploymentSelfManaged(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack_set" "test" {
+  name = %[1]q
+
+  auto_deployment {
+    enabled = true
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccStackSetTemplateBodyVPC(rName))
+}
+
 func testAccStackSetConfig_operationPreferences(rName string, failureToleranceCount, maxConcurrentCount int) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -53,6 +53,14 @@ func ResourceMetricStream() *schema.Resource {
 				ConflictsWith: []string{"include_filter"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -72,6 +80,14 @@ func ResourceMetricStream() *schema.Resource {
 				ConflictsWith: []string{"exclude_filter"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"metric_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+						},
 						"namespace": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -344,6 +360,10 @@ func expandMetricStreamFilters(s *schema.Set) []*cloudwatch.MetricStreamFilter {
 		filter := &cloudwatch.MetricStreamFilter{}
 		mFilter := filterRaw.(map[string]interface{})
 
+		if v, ok := mFilter["metric_names"].(*schema.Set); ok && v.Len() > 0 {
+			filter.MetricNames = flex.ExpandStringSet(v)
+		}
+
 		if v, ok := mFilter["namespace"].(string); ok && v != "" {
 			filter.Namespace = aws.String(v)
 		}
@@ -362,6 +382,10 @@ func flattenMetricStreamFilters(s []*cloudwatch.MetricStreamFilter) []map[string
 			stage := make(map[string]interface{})
 			stage["namespace"] = aws.StringValue(bd.Namespace)
 
+			if bd.MetricNames != nil {
+				stage["metric_names"] = flex.FlattenStringSet(bd.MetricNames)
+			}
+
 			filters = append(filters, stage)
 		}
 	}
@@ -165,6 +165,73 @@ func TestAccCloudWatchMetricStream_excludeFilters(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchMetricStream_includeFiltersWithMetricNames(t *testing.T) {
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamIncludeFiltersWithMetricNamesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "include_filter.*", map[string]string{
+						"namespace":      "AWS/EC2",
+						"metric_names.#": "2",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "include_filter.*.metric_names.*", "CPUUtilization"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "include_filter.*.metric_names.*", "NetworkOut"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "include_filter.*", map[string]string{
+						"namespace":      "AWS/EBS",
+						"metric_names.#": "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchMetricStream_excludeFiltersWithMetricNames(t *testing.T) {
+	resourceName := "aws_cloudwatch_metric_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckMetricStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricStreamExcludeFiltersWithMetricNamesConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMetricStreamExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_filter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "exclude_filter.*", map[string]string{
+						"namespace":      "AWS/EC2",
+						"metric_names.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "exclude_filter.*.metric_names.*", "CPUUtilization"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudWatchMetricStream_update(t *testing.T) {
 	resourceName := "aws_cloudwatch_metric_stream.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -569,6 +636,50 @@ resource "aws_cloudwatch_metric_stream" "test" {
 `, rName)
 }
 
+func testAccMetricStreamIncludeFiltersWithMetricNamesConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = "json"
+
+  include_filter {
+    namespace    = "AWS/EC2"
+    metric_names = ["CPUUtilization", "NetworkOut"]
+  }
+
+  include_filter {
+    namespace = "AWS/EBS"
+  }
+}
+`, rName)
+}
+
+func testAccMetricStreamExcludeFiltersWithMetricNamesConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+resource "aws_cloudwatch_metric_stream" "test" {
+  name          = %[1]q
+  role_arn      = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/MyRole"
+  firehose_arn  = "arn:${data.aws_partition.current.partition}:firehose:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:deliverystream/MyFirehose"
+  output_format = "json"
+
+  exclude_filter {
+    namespace    = "AWS/EC2"
+    metric_names = ["CPUUtilization"]
+  }
+}
+`, rName)
+}
+
 func testAccMetricStreamNoNameConfig() string {
 	return `
 data "aws_partition" "current" {}
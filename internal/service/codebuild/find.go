@@ -1,6 +1,8 @@
 package codebuild
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -108,3 +110,27 @@ func FindSourceCredentialByARN(conn *codebuild.CodeBuild, arn string) (*codebuil
 
 	return result, nil
 }
+
+func FindFleetByARN(ctx context.Context, conn *codebuild.CodeBuild, arn string) (*codebuild.Fleet, error) {
+	input := &codebuild.BatchGetFleetsInput{
+		Names: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetFleetsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Fleets) == 0 || output.Fleets[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output.Fleets); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Fleets[0], nil
+}
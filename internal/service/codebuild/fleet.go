@@ -0,0 +1,481 @@
+package codebuild
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFleetCreate,
+		ReadContext:   resourceFleetRead,
+		UpdateContext: resourceFleetUpdate,
+		DeleteContext: resourceFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"compute_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(codebuild.ComputeType_Values(), false),
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(codebuild.EnvironmentType_Values(), false),
+			},
+			"fleet_service_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"overflow_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(codebuild.FleetOverflowBehavior_Values(), false),
+			},
+			"scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"scaling_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(codebuild.FleetScalingType_Values(), false),
+						},
+						"target_tracking_scaling_configs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(codebuild.FleetScalingMetricType_Values(), false),
+									},
+									"target_value": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"context": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 5,
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 16,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &codebuild.CreateFleetInput{
+		BaseCapacity:    aws.Int64(int64(d.Get("base_capacity").(int))),
+		ComputeType:     aws.String(d.Get("compute_type").(string)),
+		EnvironmentType: aws.String(d.Get("environment_type").(string)),
+		Name:            aws.String(name),
+	}
+
+	if v, ok := d.GetOk("fleet_service_role"); ok {
+		input.FleetServiceRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("overflow_behavior"); ok {
+		input.OverflowBehavior = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScalingConfiguration = expandFleetScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok && len(v.([]interface{})) > 0 {
+		input.VpcConfig = expandVPCConfig(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CodeBuild Fleet: %s", input)
+	output, err := conn.CreateFleetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CodeBuild Fleet (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Fleet.Arn))
+
+	if _, err := waitFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CodeBuild Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	fleet, err := FindFleetByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeBuild Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", fleet.Arn)
+	d.Set("base_capacity", fleet.BaseCapacity)
+	d.Set("compute_type", fleet.ComputeType)
+	if fleet.Created != nil {
+		d.Set("created", aws.TimeValue(fleet.Created).Format(time.RFC3339))
+	}
+	d.Set("environment_type", fleet.EnvironmentType)
+	d.Set("fleet_service_role", fleet.FleetServiceRole)
+	if fleet.LastModified != nil {
+		d.Set("last_modified", aws.TimeValue(fleet.LastModified).Format(time.RFC3339))
+	}
+	d.Set("name", fleet.Name)
+	d.Set("overflow_behavior", fleet.OverflowBehavior)
+
+	if err := d.Set("scaling_configuration", flattenFleetScalingConfiguration(fleet.ScalingConfiguration)); err != nil {
+		return diag.Errorf("setting scaling_configuration: %s", err)
+	}
+
+	if err := d.Set("status", flattenFleetStatus(fleet.Status)); err != nil {
+		return diag.Errorf("setting status: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenVPCConfig(fleet.VpcConfig)); err != nil {
+		return diag.Errorf("setting vpc_config: %s", err)
+	}
+
+	tags := KeyValueTags(fleet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+
+	input := &codebuild.UpdateFleetInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("base_capacity") {
+		input.BaseCapacity = aws.Int64(int64(d.Get("base_capacity").(int)))
+	}
+
+	if d.HasChange("compute_type") {
+		input.ComputeType = aws.String(d.Get("compute_type").(string))
+	}
+
+	if d.HasChange("environment_type") {
+		input.EnvironmentType = aws.String(d.Get("environment_type").(string))
+	}
+
+	if d.HasChange("fleet_service_role") {
+		input.FleetServiceRole = aws.String(d.Get("fleet_service_role").(string))
+	}
+
+	if d.HasChange("overflow_behavior") {
+		input.OverflowBehavior = aws.String(d.Get("overflow_behavior").(string))
+	}
+
+	if d.HasChange("scaling_configuration") {
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfiguration = expandFleetScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.ScalingConfiguration = &codebuild.ScalingConfigurationInput_{}
+		}
+	}
+
+	if d.HasChange("vpc_config") {
+		input.VpcConfig = expandVPCConfig(d.Get("vpc_config").([]interface{}))
+	}
+
+	// The full set of tags is sent with every update, otherwise they are removed.
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	input.Tags = Tags(tags.IgnoreAWS())
+
+	log.Printf("[DEBUG] Updating CodeBuild Fleet: %s", input)
+	_, err := conn.UpdateFleetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for CodeBuild Fleet (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceFleetRead(ctx, d, meta)
+}
+
+func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CodeBuildConn
+
+	log.Printf("[DEBUG] Deleting CodeBuild Fleet: %s", d.Id())
+	_, err := conn.DeleteFleetWithContext(ctx, &codebuild.DeleteFleetInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, codebuild.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CodeBuild Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CodeBuild Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFleetScalingConfiguration(tfMap map[string]interface{}) *codebuild.ScalingConfigurationInput_ {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codebuild.ScalingConfigurationInput_{}
+
+	if v, ok := tfMap["max_capacity"].(int); ok && v != 0 {
+		apiObject.MaxCapacity = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scaling_type"].(string); ok && v != "" {
+		apiObject.ScalingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_tracking_scaling_configs"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TargetTrackingScalingConfigs = expandFleetTargetTrackingScalingConfigs(v)
+	}
+
+	return apiObject
+}
+
+func expandFleetTargetTrackingScalingConfigs(tfList []interface{}) []*codebuild.TargetTrackingScalingConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codebuild.TargetTrackingScalingConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codebuild.TargetTrackingScalingConfiguration{}
+
+		if v, ok := tfMap["metric_type"].(string); ok && v != "" {
+			apiObject.MetricType = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_value"].(float64); ok && v != 0 {
+			apiObject.TargetValue = aws.Float64(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenFleetScalingConfiguration(apiObject *codebuild.ScalingConfigurationOutput_) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DesiredCapacity; v != nil {
+		tfMap["desired_capacity"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.MaxCapacity; v != nil {
+		tfMap["max_capacity"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.ScalingType; v != nil {
+		tfMap["scaling_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TargetTrackingScalingConfigs; v != nil {
+		tfMap["target_tracking_scaling_configs"] = flattenFleetTargetTrackingScalingConfigs(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFleetTargetTrackingScalingConfigs(apiObjects []*codebuild.TargetTrackingScalingConfiguration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.MetricType; v != nil {
+			tfMap["metric_type"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.TargetValue; v != nil {
+			tfMap["target_value"] = aws.Float64Value(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFleetStatus(apiObject *codebuild.FleetStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"context":     aws.StringValue(apiObject.Context),
+		"message":     aws.StringValue(apiObject.Message),
+		"status_code": aws.StringValue(apiObject.StatusCode),
+	}
+
+	return []interface{}{tfMap}
+}
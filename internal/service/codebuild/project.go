@@ -236,6 +236,20 @@ func ResourceProject() *schema.Resource {
 								},
 							},
 						},
+						"fleet": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fleet_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"image": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -1050,6 +1064,18 @@ func expandProjectEnvironment(d *schema.ResourceData) *codebuild.ProjectEnvironm
 		projectEnv.ImagePullCredentialsType = aws.String(v.(string))
 	}
 
+	if v, ok := envConfig["fleet"]; ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config := v.([]interface{})[0].(map[string]interface{})
+
+		projectFleet := &codebuild.ProjectFleet{}
+
+		if v, ok := config["fleet_arn"]; ok && v.(string) != "" {
+			projectFleet.FleetArn = aws.String(v.(string))
+		}
+
+		projectEnv.Fleet = projectFleet
+	}
+
 	if v, ok := envConfig["registry_credential"]; ok && len(v.([]interface{})) > 0 {
 		config := v.([]interface{})[0].(map[string]interface{})
 
@@ -1786,6 +1812,7 @@ func flattenProjectEnvironment(environment *codebuild.ProjectEnvironment) []inte
 	envConfig["privileged_mode"] = aws.BoolValue(environment.PrivilegedMode)
 	envConfig["image_pull_credentials_type"] = aws.StringValue(environment.ImagePullCredentialsType)
 
+	envConfig["fleet"] = flattenProjectFleet(environment.Fleet)
 	envConfig["registry_credential"] = flattenRegistryCredential(environment.RegistryCredential)
 
 	if environment.EnvironmentVariables != nil {
@@ -1795,6 +1822,18 @@ func flattenProjectEnvironment(environment *codebuild.ProjectEnvironment) []inte
 	return []interface{}{envConfig}
 }
 
+func flattenProjectFleet(projectFleet *codebuild.ProjectFleet) []interface{} {
+	if projectFleet == nil {
+		return []interface{}{}
+	}
+
+	values := map[string]interface{}{
+		"fleet_arn": aws.StringValue(projectFleet.FleetArn),
+	}
+
+	return []interface{}{values}
+}
+
 func flattenRegistryCredential(registryCredential *codebuild.RegistryCredential) []interface{} {
 	if registryCredential == nil {
 		return []interface{}{}
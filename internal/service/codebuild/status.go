@@ -1,9 +1,12 @@
 package codebuild
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -26,3 +29,23 @@ func statusReportGroup(conn *codebuild.CodeBuild, arn string) resource.StateRefr
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusFleet(ctx context.Context, conn *codebuild.CodeBuild, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFleetByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.Status == nil {
+			return output, "", nil
+		}
+
+		return output, aws.StringValue(output.Status.StatusCode), nil
+	}
+}
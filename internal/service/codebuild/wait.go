@@ -1,10 +1,14 @@
 package codebuild
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -29,3 +33,45 @@ func waitReportGroupDeleted(conn *codebuild.CodeBuild, arn string) (*codebuild.R
 
 	return nil, err
 }
+
+func waitFleetActive(ctx context.Context, conn *codebuild.CodeBuild, arn string, timeout time.Duration) (*codebuild.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{codebuild.FleetStatusCodeCreating, codebuild.FleetStatusCodeUpdating, codebuild.FleetStatusCodeRotating},
+		Target:  []string{codebuild.FleetStatusCodeActive},
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codebuild.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeleted(ctx context.Context, conn *codebuild.CodeBuild, arn string, timeout time.Duration) (*codebuild.Fleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{codebuild.FleetStatusCodePendingDeletion, codebuild.FleetStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: statusFleet(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*codebuild.Fleet); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
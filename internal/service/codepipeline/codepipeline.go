@@ -1,6 +1,7 @@
 package codepipeline
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -52,10 +54,16 @@ func ResourceCodePipeline() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 100),
-					validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9.@\-_]+$`), ""),
 				),
 			},
 
+			"pipeline_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      codepipeline.PipelineTypeV1,
+				ValidateFunc: validation.StringInSlice(codepipeline.PipelineType_Values(), false),
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -112,7 +120,7 @@ func ResourceCodePipeline() *schema.Resource {
 							Required: true,
 							ValidateFunc: validation.All(
 								validation.StringLenBetween(1, 100),
-								validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+								validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9.@\-_]+$`), ""),
 							),
 						},
 						"action": {
@@ -168,7 +176,7 @@ func ResourceCodePipeline() *schema.Resource {
 										Required: true,
 										ValidateFunc: validation.All(
 											validation.StringLenBetween(1, 100),
-											validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9.@\-_]+`), ""),
+											validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9.@\-_]+$`), ""),
 										),
 									},
 									"role_arn": {
@@ -203,9 +211,133 @@ func ResourceCodePipeline() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"git_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"pull_request": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches": gitFilterCriteriaSchema(),
+												"events": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 3,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validation.StringInSlice(codepipeline.GitPullRequestEventType_Values(), false),
+													},
+												},
+												"file_paths": gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"push": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"branches":   gitFilterCriteriaSchema(),
+												"file_paths": gitFilterCriteriaSchema(),
+												"tags":       gitFilterCriteriaSchema(),
+											},
+										},
+									},
+									"source_action_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 100),
+											validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9.@\-_]+$`), ""),
+										),
+									},
+								},
+							},
+						},
+						"provider_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(codepipeline.PipelineTriggerProviderType_Values(), false),
+						},
+					},
+				},
+			},
+			"variable": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1000),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9@\-_]+$`), "must contain only alphanumeric characters, hyphens, underscores and @"),
+							),
+						},
+					},
+				},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceValidatePipelineType,
+		),
+	}
+}
+
+func gitFilterCriteriaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"excludes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+				"includes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 8,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(1, 255),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -256,9 +388,18 @@ func resourceCreate(d *schema.ResourceData, meta interface{}) error {
 
 func expand(d *schema.ResourceData) (*codepipeline.PipelineDeclaration, error) {
 	pipeline := codepipeline.PipelineDeclaration{
-		Name:    aws.String(d.Get("name").(string)),
-		RoleArn: aws.String(d.Get("role_arn").(string)),
-		Stages:  expandStages(d),
+		Name:         aws.String(d.Get("name").(string)),
+		PipelineType: aws.String(d.Get("pipeline_type").(string)),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Stages:       expandStages(d),
+	}
+
+	if v, ok := d.GetOk("trigger"); ok && len(v.([]interface{})) > 0 {
+		pipeline.Triggers = expandTriggerDeclarations(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("variable"); ok && len(v.([]interface{})) > 0 {
+		pipeline.Variables = expandVariableDeclarations(v.([]interface{}))
 	}
 
 	pipelineArtifactStores, err := ExpandArtifactStores(d.Get("artifact_store").(*schema.Set).List())
@@ -567,8 +708,17 @@ func resourceRead(d *schema.ResourceData, meta interface{}) error {
 	arn := aws.StringValue(metadata.PipelineArn)
 	d.Set("arn", arn)
 	d.Set("name", pipeline.Name)
+	d.Set("pipeline_type", pipeline.PipelineType)
 	d.Set("role_arn", pipeline.RoleArn)
 
+	if err := d.Set("trigger", flattenTriggerDeclarations(pipeline.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %w", err)
+	}
+
+	if err := d.Set("variable", flattenVariableDeclarations(pipeline.Variables)); err != nil {
+		return fmt.Errorf("error setting variable: %w", err)
+	}
+
 	tags, err := ListTags(conn, arn)
 
 	if err != nil {
@@ -637,6 +787,20 @@ func resourceDelete(d *schema.ResourceData, meta interface{}) error {
 	return err
 }
 
+func resourceValidatePipelineType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get("pipeline_type").(string) == codepipeline.PipelineTypeV2 {
+		return nil
+	}
+
+	for _, k := range []string{"trigger", "variable"} {
+		if v, ok := diff.GetOk(k); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("%q can only be configured when pipeline_type is %q", k, codepipeline.PipelineTypeV2)
+		}
+	}
+
+	return nil
+}
+
 func resourceValidateActionProvider(i interface{}, path cty.Path) diag.Diagnostics {
 	v, ok := i.(string)
 	if !ok {
@@ -691,3 +855,331 @@ func allDiagFunc(validators ...schema.SchemaValidateDiagFunc) schema.SchemaValid
 		return diags
 	}
 }
+
+func expandVariableDeclarations(tfList []interface{}) []*codepipeline.PipelineVariableDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineVariableDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineVariableDeclaration{}
+
+		if v, ok := tfMap["default_value"].(string); ok && v != "" {
+			apiObject.DefaultValue = aws.String(v)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenVariableDeclarations(apiObjects []*codepipeline.PipelineVariableDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.DefaultValue; v != nil {
+			tfMap["default_value"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Description; v != nil {
+			tfMap["description"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.Name; v != nil {
+			tfMap["name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandTriggerDeclarations(tfList []interface{}) []*codepipeline.PipelineTriggerDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*codepipeline.PipelineTriggerDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.PipelineTriggerDeclaration{}
+
+		if v, ok := tfMap["git_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.GitConfiguration = expandGitConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["provider_type"].(string); ok && v != "" {
+			apiObject.ProviderType = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitConfiguration(tfMap map[string]interface{}) *codepipeline.GitConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &codepipeline.GitConfiguration{}
+
+	if v, ok := tfMap["pull_request"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PullRequest = expandGitPullRequestFilters(v)
+	}
+
+	if v, ok := tfMap["push"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Push = expandGitPushFilters(v)
+	}
+
+	if v, ok := tfMap["source_action_name"].(string); ok && v != "" {
+		apiObject.SourceActionName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGitPullRequestFilters(tfList []interface{}) []*codepipeline.GitPullRequestFilter {
+	var apiObjects []*codepipeline.GitPullRequestFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPullRequestFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &codepipeline.GitBranchFilterCriteria{
+				Excludes: excludes,
+				Includes: includes,
+			}
+		}
+
+		if v, ok := tfMap["events"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Events = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &codepipeline.GitFilePathFilterCriteria{
+				Excludes: excludes,
+				Includes: includes,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGitPushFilters(tfList []interface{}) []*codepipeline.GitPushFilter {
+	var apiObjects []*codepipeline.GitPushFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &codepipeline.GitPushFilter{}
+
+		if v, ok := tfMap["branches"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Branches = &codepipeline.GitBranchFilterCriteria{
+				Excludes: excludes,
+				Includes: includes,
+			}
+		}
+
+		if v, ok := tfMap["file_paths"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.FilePaths = &codepipeline.GitFilePathFilterCriteria{
+				Excludes: excludes,
+				Includes: includes,
+			}
+		}
+
+		if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			includes, excludes := expandGitFilterCriteria(v[0].(map[string]interface{}))
+			apiObject.Tags = &codepipeline.GitTagFilterCriteria{
+				Excludes: excludes,
+				Includes: includes,
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandGitFilterCriteria returns the includes and excludes of a branch, file path or tag filter.
+func expandGitFilterCriteria(tfMap map[string]interface{}) ([]*string, []*string) {
+	var includes, excludes []*string
+
+	if v, ok := tfMap["includes"].([]interface{}); ok && len(v) > 0 {
+		includes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["excludes"].([]interface{}); ok && len(v) > 0 {
+		excludes = flex.ExpandStringList(v)
+	}
+
+	return includes, excludes
+}
+
+func flattenTriggerDeclarations(apiObjects []*codepipeline.PipelineTriggerDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.GitConfiguration; v != nil {
+			tfMap["git_configuration"] = []interface{}{flattenGitConfiguration(v)}
+		}
+
+		if v := apiObject.ProviderType; v != nil {
+			tfMap["provider_type"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitConfiguration(apiObject *codepipeline.GitConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PullRequest; v != nil {
+		tfMap["pull_request"] = flattenGitPullRequestFilters(v)
+	}
+
+	if v := apiObject.Push; v != nil {
+		tfMap["push"] = flattenGitPushFilters(v)
+	}
+
+	if v := apiObject.SourceActionName; v != nil {
+		tfMap["source_action_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenGitPullRequestFilters(apiObjects []*codepipeline.GitPullRequestFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.Events; v != nil {
+			tfMap["events"] = aws.StringValueSlice(v)
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitPushFilters(apiObjects []*codepipeline.GitPushFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Branches; v != nil {
+			tfMap["branches"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.FilePaths; v != nil {
+			tfMap["file_paths"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		if v := apiObject.Tags; v != nil {
+			tfMap["tags"] = flattenGitFilterCriteria(v.Includes, v.Excludes)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenGitFilterCriteria(includes, excludes []*string) []interface{} {
+	tfMap := map[string]interface{}{
+		"excludes": aws.StringValueSlice(excludes),
+		"includes": aws.StringValueSlice(includes),
+	}
+
+	return []interface{}{tfMap}
+}
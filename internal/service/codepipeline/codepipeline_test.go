@@ -42,6 +42,7 @@ func TestAccCodePipeline_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.codepipeline_role", "arn"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "codepipeline", regexp.MustCompile(fmt.Sprintf("test-pipeline-%s", name))),
 					resource.TestCheckResourceAttr(resourceName, "artifact_store.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", codepipeline.PipelineTypeV1),
 
 					resource.TestCheckResourceAttr(resourceName, "stage.#", "2"),
 
@@ -555,6 +556,80 @@ func TestAccCodePipeline_withGitHubV1SourceAction(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_pipelineTypeV2(t *testing.T) {
+	var p1, p2 codepipeline.PipelineDeclaration
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineTypeV2Config(name, "main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &p1),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", codepipeline.PipelineTypeV2),
+					resource.TestCheckResourceAttr(resourceName, "variable.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.name", "test_var"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "main"),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.description", "Test variable"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.provider_type", codepipeline.PipelineTriggerProviderTypeCodeStarSourceConnection),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.source_action_name", "Source"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "main"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.file_paths.0.excludes.0", "docs/**"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.pull_request.0.events.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineTypeV2Config(name, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &p2),
+					resource.TestCheckResourceAttr(resourceName, "variable.0.default_value", "release"),
+					resource.TestCheckResourceAttr(resourceName, "trigger.0.git_configuration.0.push.0.branches.0.includes.0", "release"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodePipeline_pipelineTypeV1Variables(t *testing.T) {
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineTypeV1VariablesConfig(name),
+				ExpectError: regexp.MustCompile(`"variable" can only be configured when pipeline_type is "V2"`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, pipeline *codepipeline.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1559,6 +1634,163 @@ resource "aws_codepipeline" "test" {
 `, rName, githubToken))
 }
 
+func testAccPipelineTypeBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+
+resource "aws_s3_bucket" "foo" {
+  bucket = "tf-test-pipeline-%[1]s"
+}
+`, rName))
+}
+
+func testAccPipelineTypeV2Config(rName, branch string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineTypeBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  pipeline_type = "V2"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.foo.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name          = "test_var"
+    default_value = %[2]q
+    description   = "Test variable"
+  }
+
+  trigger {
+    provider_type = "CodeStarSourceConnection"
+
+    git_configuration {
+      source_action_name = "Source"
+
+      push {
+        branches {
+          includes = [%[2]q]
+        }
+
+        file_paths {
+          excludes = ["docs/**"]
+        }
+      }
+
+      pull_request {
+        events = ["OPEN", "UPDATED"]
+
+        branches {
+          includes = [%[2]q]
+        }
+      }
+    }
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = %[2]q
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName, branch))
+}
+
+func testAccPipelineTypeV1VariablesConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineTypeBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  pipeline_type = "V1"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.foo.bucket
+    type     = "S3"
+  }
+
+  variable {
+    name = "test_var"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func TestExpandArtifactStoresValidation(t *testing.T) {
 	cases := []struct {
 		Name          string
@@ -6,6 +6,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindCognitoUserPoolUICustomization returns the UI Customization corresponding to the UserPoolId and ClientId.
@@ -73,3 +76,40 @@ func FindCognitoUserInGroup(conn *cognitoidentityprovider.CognitoIdentityProvide
 
 	return found, nil
 }
+
+func FindRiskConfigurationByTwoPartKey(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, clientID string) (*cognitoidentityprovider.RiskConfigurationType, error) {
+	input := &cognitoidentityprovider.DescribeRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	output, err := conn.DescribeRiskConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RiskConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// An empty risk configuration is returned when none has been set.
+	riskConfig := output.RiskConfiguration
+	if riskConfig.AccountTakeoverRiskConfiguration == nil && riskConfig.CompromisedCredentialsRiskConfiguration == nil && riskConfig.RiskExceptionConfiguration == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return riskConfig, nil
+}
@@ -0,0 +1,633 @@
+package cognitoidp
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRiskConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRiskConfigurationPut,
+		Read:   resourceRiskConfigurationRead,
+		Update: resourceRiskConfigurationPut,
+		Delete: resourceRiskConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_SetRiskConfiguration.html
+		Schema: map[string]*schema.Schema{
+			"account_takeover_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				AtLeastOneOf: []string{
+					"account_takeover_risk_configuration",
+					"compromised_credentials_risk_configuration",
+					"risk_exception_configuration",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"high_action":   accountTakeoverActionSchema(),
+									"low_action":    accountTakeoverActionSchema(),
+									"medium_action": accountTakeoverActionSchema(),
+								},
+							},
+						},
+						"notify_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"block_email": notifyEmailSchema(),
+									"from": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"mfa_email":       notifyEmailSchema(),
+									"no_action_email": notifyEmailSchema(),
+									"reply_to": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"compromised_credentials_risk_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				AtLeastOneOf: []string{
+					"account_takeover_risk_configuration",
+					"compromised_credentials_risk_configuration",
+					"risk_exception_configuration",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"event_action": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cognitoidentityprovider.CompromisedCredentialsEventActionType_Values(), false),
+									},
+								},
+							},
+						},
+						"event_filter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cognitoidentityprovider.EventFilterType_Values(), false),
+							},
+						},
+					},
+				},
+			},
+			"risk_exception_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				AtLeastOneOf: []string{
+					"account_takeover_risk_configuration",
+					"compromised_credentials_risk_configuration",
+					"risk_exception_configuration",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocked_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							AtLeastOneOf: []string{
+								"risk_exception_configuration.0.blocked_ip_range_list",
+								"risk_exception_configuration.0.skipped_ip_range_list",
+							},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+						"skipped_ip_range_list": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 200,
+							AtLeastOneOf: []string{
+								"risk_exception_configuration.0.blocked_ip_range_list",
+								"risk_exception_configuration.0.skipped_ip_range_list",
+							},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsCIDR,
+							},
+						},
+					},
+				},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validUserPoolID,
+			},
+		},
+	}
+}
+
+func accountTakeoverActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"event_action": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(cognitoidentityprovider.AccountTakeoverEventActionType_Values(), false),
+				},
+				"notify": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func notifyEmailSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"html_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+				"subject": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 140),
+				},
+				"text_body": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(6, 20000),
+				},
+			},
+		},
+	}
+}
+
+func resourceRiskConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	id := userPoolID
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		input.ClientId = aws.String(v.(string))
+		id = RiskConfigurationCreateResourceID(userPoolID, v.(string))
+	}
+
+	if v, ok := d.GetOk("account_takeover_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccountTakeoverRiskConfiguration = expandAccountTakeoverRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("compromised_credentials_risk_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.CompromisedCredentialsRiskConfiguration = expandCompromisedCredentialsRiskConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("risk_exception_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RiskExceptionConfiguration = expandRiskExceptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Setting Cognito Risk Configuration: %s", input)
+	_, err := conn.SetRiskConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito Risk Configuration (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRiskConfigurationRead(d, meta)
+}
+
+func resourceRiskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID, clientID, err := RiskConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	riskConfig, err := FindRiskConfigurationByTwoPartKey(conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito Risk Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("client_id", clientID)
+	d.Set("user_pool_id", userPoolID)
+
+	if err := d.Set("account_takeover_risk_configuration", flattenAccountTakeoverRiskConfiguration(riskConfig.AccountTakeoverRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting account_takeover_risk_configuration: %w", err)
+	}
+
+	if err := d.Set("compromised_credentials_risk_configuration", flattenCompromisedCredentialsRiskConfiguration(riskConfig.CompromisedCredentialsRiskConfiguration)); err != nil {
+		return fmt.Errorf("error setting compromised_credentials_risk_configuration: %w", err)
+	}
+
+	if err := d.Set("risk_exception_configuration", flattenRiskExceptionConfiguration(riskConfig.RiskExceptionConfiguration)); err != nil {
+		return fmt.Errorf("error setting risk_exception_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceRiskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CognitoIDPConn
+
+	userPoolID, clientID, err := RiskConfigurationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &cognitoidentityprovider.SetRiskConfigurationInput{
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if clientID != "" {
+		input.ClientId = aws.String(clientID)
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito Risk Configuration: %s", d.Id())
+	_, err = conn.SetRiskConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito Risk Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const riskConfigurationResourceIDSeparator = ":"
+
+func RiskConfigurationCreateResourceID(userPoolID, clientID string) string {
+	parts := []string{userPoolID, clientID}
+	id := strings.Join(parts, riskConfigurationResourceIDSeparator)
+
+	return id
+}
+
+// RiskConfigurationParseResourceID parses an ID of the form USER-POOL-ID or
+// USER-POOL-ID:CLIENT-ID. The client ID is empty for user pool level configuration.
+func RiskConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, riskConfigurationResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USER-POOL-ID or USER-POOL-ID%[2]sCLIENT-ID", id, riskConfigurationResourceIDSeparator)
+}
+
+func expandAccountTakeoverRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Actions = expandAccountTakeoverActions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["notify_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NotifyConfiguration = expandNotifyConfiguration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAccountTakeoverActions(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverActionsType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverActionsType{}
+
+	if v, ok := tfMap["high_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HighAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["low_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LowAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["medium_action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MediumAction = expandAccountTakeoverAction(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAccountTakeoverAction(tfMap map[string]interface{}) *cognitoidentityprovider.AccountTakeoverActionType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.AccountTakeoverActionType{}
+
+	if v, ok := tfMap["event_action"].(string); ok && v != "" {
+		apiObject.EventAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["notify"].(bool); ok {
+		apiObject.Notify = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandNotifyConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.NotifyConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.NotifyConfigurationType{}
+
+	if v, ok := tfMap["block_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BlockEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["from"].(string); ok && v != "" {
+		apiObject.From = aws.String(v)
+	}
+
+	if v, ok := tfMap["mfa_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MfaEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["no_action_email"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NoActionEmail = expandNotifyEmail(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["reply_to"].(string); ok && v != "" {
+		apiObject.ReplyTo = aws.String(v)
+	}
+
+	if v, ok := tfMap["source_arn"].(string); ok && v != "" {
+		apiObject.SourceArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNotifyEmail(tfMap map[string]interface{}) *cognitoidentityprovider.NotifyEmailType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.NotifyEmailType{}
+
+	if v, ok := tfMap["html_body"].(string); ok && v != "" {
+		apiObject.HtmlBody = aws.String(v)
+	}
+
+	if v, ok := tfMap["subject"].(string); ok && v != "" {
+		apiObject.Subject = aws.String(v)
+	}
+
+	if v, ok := tfMap["text_body"].(string); ok && v != "" {
+		apiObject.TextBody = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCompromisedCredentialsRiskConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType{}
+
+	if v, ok := tfMap["actions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Actions = &cognitoidentityprovider.CompromisedCredentialsActionsType{}
+
+		if v, ok := tfMap["event_action"].(string); ok && v != "" {
+			apiObject.Actions.EventAction = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["event_filter"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EventFilter = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandRiskExceptionConfiguration(tfMap map[string]interface{}) *cognitoidentityprovider.RiskExceptionConfigurationType {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cognitoidentityprovider.RiskExceptionConfigurationType{}
+
+	if v, ok := tfMap["blocked_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.BlockedIPRangeList = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["skipped_ip_range_list"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SkippedIPRangeList = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccountTakeoverRiskConfiguration(apiObject *cognitoidentityprovider.AccountTakeoverRiskConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["actions"] = flattenAccountTakeoverActions(v)
+	}
+
+	if v := apiObject.NotifyConfiguration; v != nil {
+		tfMap["notify_configuration"] = flattenNotifyConfiguration(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAccountTakeoverActions(apiObject *cognitoidentityprovider.AccountTakeoverActionsType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HighAction; v != nil {
+		tfMap["high_action"] = flattenAccountTakeoverAction(v)
+	}
+
+	if v := apiObject.LowAction; v != nil {
+		tfMap["low_action"] = flattenAccountTakeoverAction(v)
+	}
+
+	if v := apiObject.MediumAction; v != nil {
+		tfMap["medium_action"] = flattenAccountTakeoverAction(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAccountTakeoverAction(apiObject *cognitoidentityprovider.AccountTakeoverActionType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"event_action": aws.StringValue(apiObject.EventAction),
+		"notify":       aws.BoolValue(apiObject.Notify),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotifyConfiguration(apiObject *cognitoidentityprovider.NotifyConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"from":       aws.StringValue(apiObject.From),
+		"reply_to":   aws.StringValue(apiObject.ReplyTo),
+		"source_arn": aws.StringValue(apiObject.SourceArn),
+	}
+
+	if v := apiObject.BlockEmail; v != nil {
+		tfMap["block_email"] = flattenNotifyEmail(v)
+	}
+
+	if v := apiObject.MfaEmail; v != nil {
+		tfMap["mfa_email"] = flattenNotifyEmail(v)
+	}
+
+	if v := apiObject.NoActionEmail; v != nil {
+		tfMap["no_action_email"] = flattenNotifyEmail(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenNotifyEmail(apiObject *cognitoidentityprovider.NotifyEmailType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"html_body": aws.StringValue(apiObject.HtmlBody),
+		"subject":   aws.StringValue(apiObject.Subject),
+		"text_body": aws.StringValue(apiObject.TextBody),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCompromisedCredentialsRiskConfiguration(apiObject *cognitoidentityprovider.CompromisedCredentialsRiskConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"event_filter": aws.StringValueSlice(apiObject.EventFilter),
+	}
+
+	if v := apiObject.Actions; v != nil {
+		tfMap["actions"] = []interface{}{map[string]interface{}{
+			"event_action": aws.StringValue(v.EventAction),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRiskExceptionConfiguration(apiObject *cognitoidentityprovider.RiskExceptionConfigurationType) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"blocked_ip_range_list": aws.StringValueSlice(apiObject.BlockedIPRangeList),
+		"skipped_ip_range_list": aws.StringValueSlice(apiObject.SkippedIPRangeList),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,318 @@
+package cognitoidp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCognitoIDPRiskConfiguration_exception(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskConfigurationConfig_riskException(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.*", "10.10.10.10/32"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.skipped_ip_range_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRiskConfigurationConfig_riskExceptionUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.*", "10.10.10.10/32"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.blocked_ip_range_list.*", "10.10.10.11/32"),
+					resource.TestCheckResourceAttr(resourceName, "risk_exception_configuration.0.skipped_ip_range_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "risk_exception_configuration.0.skipped_ip_range_list.*", "10.10.10.12/32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPRiskConfiguration_client(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskConfigurationConfig_client(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", "aws_cognito_user_pool.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", "aws_cognito_user_pool_client.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.actions.0.event_action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "compromised_credentials_risk_configuration.0.event_filter.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compromised_credentials_risk_configuration.0.event_filter.*", "SIGN_IN"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPRiskConfiguration_accountTakeover(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskConfigurationConfig_accountTakeover(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRiskConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.event_action", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.high_action.0.notify", "true"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.medium_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.medium_action.0.event_action", "MFA_IF_CONFIGURED"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.low_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.actions.0.low_action.0.event_action", "NO_ACTION"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.notify_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_takeover_risk_configuration.0.notify_configuration.0.source_arn", "aws_ses_email_identity.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.notify_configuration.0.block_email.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "account_takeover_risk_configuration.0.notify_configuration.0.block_email.0.subject", "block subject"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPRiskConfiguration_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_risk_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIdentityProvider(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRiskConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRiskConfigurationConfig_riskException(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRiskConfigurationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcognitoidp.ResourceRiskConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRiskConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_risk_configuration" {
+			continue
+		}
+
+		userPoolID, clientID, err := tfcognitoidp.RiskConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfcognitoidp.FindRiskConfigurationByTwoPartKey(conn, userPoolID, clientID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cognito Risk Configuration %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckRiskConfigurationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cognito Risk Configuration ID is set")
+		}
+
+		userPoolID, clientID, err := tfcognitoidp.RiskConfigurationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+
+		_, err = tfcognitoidp.FindRiskConfigurationByTwoPartKey(conn, userPoolID, clientID)
+
+		return err
+	}
+}
+
+func testAccRiskConfigurationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  user_pool_add_ons {
+    advanced_security_mode = "ENFORCED"
+  }
+}
+`, rName)
+}
+
+func testAccRiskConfigurationConfig_riskException(rName string) string {
+	return acctest.ConfigCompose(testAccRiskConfigurationBaseConfig(rName), `
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  risk_exception_configuration {
+    blocked_ip_range_list = ["10.10.10.10/32"]
+  }
+}
+`)
+}
+
+func testAccRiskConfigurationConfig_riskExceptionUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccRiskConfigurationBaseConfig(rName), `
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  risk_exception_configuration {
+    blocked_ip_range_list = ["10.10.10.10/32", "10.10.10.11/32"]
+    skipped_ip_range_list = ["10.10.10.12/32"]
+  }
+}
+`)
+}
+
+func testAccRiskConfigurationConfig_client(rName string) string {
+	return acctest.ConfigCompose(testAccRiskConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+  client_id    = aws_cognito_user_pool_client.test.id
+
+  compromised_credentials_risk_configuration {
+    event_filter = ["SIGN_IN"]
+
+    actions {
+      event_action = "BLOCK"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRiskConfigurationConfig_accountTakeover(rName string) string {
+	return acctest.ConfigCompose(testAccRiskConfigurationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ses_email_identity" "test" {
+  email = %[1]q
+}
+
+resource "aws_cognito_risk_configuration" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+
+  account_takeover_risk_configuration {
+    notify_configuration {
+      source_arn = aws_ses_email_identity.test.arn
+
+      block_email {
+        html_body = "block html body"
+        subject   = "block subject"
+        text_body = "block text body"
+      }
+
+      mfa_email {
+        html_body = "mfa html body"
+        subject   = "mfa subject"
+        text_body = "mfa text body"
+      }
+
+      no_action_email {
+        html_body = "no action html body"
+        subject   = "no action subject"
+        text_body = "no action text body"
+      }
+    }
+
+    actions {
+      high_action {
+        event_action = "BLOCK"
+        notify       = true
+      }
+
+      medium_action {
+        event_action = "MFA_IF_CONFIGURED"
+        notify       = true
+      }
+
+      low_action {
+        event_action = "NO_ACTION"
+        notify       = false
+      }
+    }
+  }
+}
+`, acctest.DefaultEmailAddress))
+}
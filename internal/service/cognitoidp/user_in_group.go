@@ -2,10 +2,12 @@ package cognitoidp
 
 import (
 	"fmt"
+	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -16,6 +18,11 @@ func ResourceUserInGroup() *schema.Resource {
 		Create: resourceUserInGroupCreate,
 		Read:   resourceUserInGroupRead,
 		Delete: resourceUserInGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceUserInGroupImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:         schema.TypeString,
@@ -62,8 +69,7 @@ func resourceUserInGroupCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error adding user to group: %w", err)
 	}
 
-	//lintignore:R015 // Allow legacy unstable ID usage in managed resource
-	d.SetId(resource.UniqueId())
+	d.SetId(UserInGroupCreateResourceID(d.Get("user_pool_id").(string), d.Get("group_name").(string), d.Get("username").(string)))
 
 	return resourceUserInGroupRead(d, meta)
 }
@@ -77,11 +83,18 @@ func resourceUserInGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	found, err := FindCognitoUserInGroup(conn, groupName, userPoolId, username)
 
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Cognito User (%s) in Group (%s) not found, removing from state", username, groupName)
+		d.SetId("")
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
 
 	if !found {
+		log.Printf("[WARN] Cognito User (%s) in Group (%s) not found, removing from state", username, groupName)
 		d.SetId("")
 	}
 
@@ -103,9 +116,46 @@ func resourceUserInGroupDelete(d *schema.ResourceData, meta interface{}) error {
 
 	_, err := conn.AdminRemoveUserFromGroup(input)
 
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeUserNotFoundException, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("error removing user from group: %w", err)
 	}
 
 	return nil
 }
+
+func resourceUserInGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	userPoolID, groupName, username, err := UserInGroupParseResourceID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("group_name", groupName)
+	d.Set("user_pool_id", userPoolID)
+	d.Set("username", username)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const userInGroupResourceIDSeparator = "/"
+
+func UserInGroupCreateResourceID(userPoolID, groupName, username string) string {
+	parts := []string{userPoolID, groupName, username}
+	id := strings.Join(parts, userInGroupResourceIDSeparator)
+
+	return id
+}
+
+func UserInGroupParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, userInGroupResourceIDSeparator, 3)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected USER-POOL-ID%[2]sGROUP-NAME%[2]sUSERNAME", id, userInGroupResourceIDSeparator)
+}
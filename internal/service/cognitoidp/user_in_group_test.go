@@ -37,6 +37,11 @@ func TestAccCognitoUserInGroup_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "username", userResourceName, "username"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
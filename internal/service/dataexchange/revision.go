@@ -41,6 +41,11 @@ func ResourceRevision() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"finalized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"revision_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -73,6 +78,13 @@ func resourceRevisionCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(out.DataSetId), aws.StringValue(out.Id)))
 
+	// Revisions cannot be finalized on creation.
+	if d.Get("finalized").(bool) {
+		if err := updateRevisionFinalized(conn, aws.StringValue(out.DataSetId), aws.StringValue(out.Id), true); err != nil {
+			return fmt.Errorf("error finalizing DataExchange Revision (%s): %w", d.Id(), err)
+		}
+	}
+
 	return resourceRevisionRead(d, meta)
 }
 
@@ -100,6 +112,7 @@ func resourceRevisionRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("data_set_id", revision.DataSetId)
 	d.Set("comment", revision.Comment)
+	d.Set("finalized", revision.Finalized)
 	d.Set("arn", revision.Arn)
 	d.Set("revision_id", revision.Id)
 
@@ -129,6 +142,10 @@ func resourceRevisionUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.Comment = aws.String(d.Get("comment").(string))
 		}
 
+		if d.HasChange("finalized") {
+			input.Finalized = aws.Bool(d.Get("finalized").(bool))
+		}
+
 		log.Printf("[DEBUG] Updating DataExchange Revision: %s", d.Id())
 		_, err := conn.UpdateRevision(input)
 		if err != nil {
@@ -150,9 +167,25 @@ func resourceRevisionUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceRevisionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).DataExchangeConn
 
+	dataSetId := d.Get("data_set_id").(string)
+	revisionId := d.Get("revision_id").(string)
+
+	// Finalized revisions must be reverted to a draft before they can be deleted.
+	if d.Get("finalized").(bool) {
+		err := updateRevisionFinalized(conn, dataSetId, revisionId, false)
+
+		if tfawserr.ErrCodeEquals(err, dataexchange.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error unfinalizing DataExchange Revision (%s): %w", d.Id(), err)
+		}
+	}
+
 	input := &dataexchange.DeleteRevisionInput{
-		RevisionId: aws.String(d.Get("revision_id").(string)),
-		DataSetId:  aws.String(d.Get("data_set_id").(string)),
+		RevisionId: aws.String(revisionId),
+		DataSetId:  aws.String(dataSetId),
 	}
 
 	log.Printf("[DEBUG] Deleting DataExchange Revision: %s", d.Id())
@@ -167,6 +200,19 @@ func resourceRevisionDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func updateRevisionFinalized(conn *dataexchange.DataExchange, dataSetId, revisionId string, finalized bool) error {
+	input := &dataexchange.UpdateRevisionInput{
+		DataSetId:  aws.String(dataSetId),
+		Finalized:  aws.Bool(finalized),
+		RevisionId: aws.String(revisionId),
+	}
+
+	log.Printf("[DEBUG] Updating DataExchange Revision (%s:%s) finalized state: %t", dataSetId, revisionId, finalized)
+	_, err := conn.UpdateRevision(input)
+
+	return err
+}
+
 func RevisionParseResourceID(id string) (string, string, error) {
 	parts := strings.Split(id, ":")
 
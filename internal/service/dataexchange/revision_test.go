@@ -31,6 +31,7 @@ func TestAccDataExchangeRevision_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRevisionExists(resourceName, &proj),
 					resource.TestCheckResourceAttrPair(resourceName, "data_set_id", "aws_dataexchange_data_set.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "finalized", "false"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dataexchange", regexp.MustCompile(`data-sets/.+/revisions/.+`)),
 				),
@@ -56,6 +56,10 @@ func ResourceCluster() *schema.Resource {
 					return old == new
 				},
 			},
+			"cluster_endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"cluster_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -334,6 +338,7 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("port", c.ClusterDiscoveryEndpoint.Port)
 		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.StringValue(c.ClusterDiscoveryEndpoint.Address), aws.Int64Value(c.ClusterDiscoveryEndpoint.Port)))
 		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+		d.Set("cluster_endpoint_url", c.ClusterDiscoveryEndpoint.URL)
 	}
 
 	d.Set("subnet_group_name", c.SubnetGroup)
@@ -201,6 +201,7 @@ func TestAccDAXCluster_EndpointEncryption_disabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(resourceName, &dc),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", clusterEndpointEncryptionType),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexp.MustCompile(`^dax://`)),
 				),
 			},
 			{
@@ -235,6 +236,7 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(resourceName, &dc),
 					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", clusterEndpointEncryptionType),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexp.MustCompile(`^daxs://`)),
 				),
 			},
 			{
@@ -90,3 +90,101 @@ func FindReplicationTaskByID(conn *dms.DatabaseMigrationService, id string) (*dm
 
 	return results[0], nil
 }
+
+func FindReplicationConfigByARN(conn *dms.DatabaseMigrationService, arn string) (*dms.ReplicationConfig, error) {
+	input := &dms.DescribeReplicationConfigsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-config-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.ReplicationConfig
+
+	err := conn.DescribeReplicationConfigsPages(input, func(page *dms.DescribeReplicationConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReplicationConfigs {
+			if v == nil {
+				continue
+			}
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
+
+func FindReplicationByReplicationConfigARN(conn *dms.DatabaseMigrationService, arn string) (*dms.Replication, error) {
+	input := &dms.DescribeReplicationsInput{
+		Filters: []*dms.Filter{
+			{
+				Name:   aws.String("replication-config-arn"),
+				Values: aws.StringSlice([]string{arn}),
+			},
+		},
+	}
+
+	var results []*dms.Replication
+
+	err := conn.DescribeReplicationsPages(input, func(page *dms.DescribeReplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Replications {
+			if v == nil {
+				continue
+			}
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
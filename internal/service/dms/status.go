@@ -38,3 +38,19 @@ func statusReplicationTask(conn *dms.DatabaseMigrationService, id string) resour
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusReplication(conn *dms.DatabaseMigrationService, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplicationByReplicationConfigARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
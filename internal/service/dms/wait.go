@@ -1,10 +1,14 @@
 package dms
 
 import (
+	"errors"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -108,3 +112,88 @@ func waitReplicationTaskStopped(conn *dms.DatabaseMigrationService, id string) e
 
 	return err
 }
+
+func waitReplicationRunning(conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			replicationStatusReady,
+			replicationStatusCreated,
+			replicationStatusTestingConnection,
+			replicationStatusFetchingMetadata,
+			replicationStatusCalculatingCapacity,
+			replicationStatusProvisioningCapacity,
+			replicationStatusReplicationStarting,
+			replicationStatusInitializing,
+			replicationStatusMetadataResources,
+		},
+		Target:     []string{replicationStatusRunning},
+		Refresh:    statusReplication(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		if v := output.FailureMessages; len(v) > 0 {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(v), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationStopped(conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{replicationStatusStopping, replicationStatusRunning},
+		Target:     []string{replicationStatusStopped},
+		Refresh:    statusReplication(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      60 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		if v := output.FailureMessages; len(v) > 0 {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(v), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplicationDeleted(conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) (*dms.Replication, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			replicationTaskStatusDeleting,
+			replicationStatusCreated,
+			replicationStatusFailed,
+			replicationStatusReady,
+			replicationStatusStopped,
+		},
+		Target:     []string{},
+		Refresh:    statusReplication(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*dms.Replication); ok {
+		if v := output.FailureMessages; len(v) > 0 {
+			tfresource.SetLastError(err, errors.New(strings.Join(aws.StringValueSlice(v), "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,384 @@
+package docdbelastic
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/docdbelastic"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterCreate,
+		ReadWithoutTimeout:   resourceClusterRead,
+		UpdateWithoutTimeout: resourceClusterUpdate,
+		DeleteWithoutTimeout: resourceClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"admin_user_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(docdbelastic.Auth_Values(), false),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"preferred_maintenance_window": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				StateFunc: func(val interface{}) string {
+					if val == nil {
+						return ""
+					}
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+			},
+			"shard_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{2, 4, 8, 16, 32, 64}),
+			},
+			"shard_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DocDBElasticConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &docdbelastic.CreateClusterInput{
+		AdminUserName:     aws.String(d.Get("admin_user_name").(string)),
+		AdminUserPassword: aws.String(d.Get("admin_user_password").(string)),
+		AuthType:          aws.String(d.Get("auth_type").(string)),
+		ClientToken:       aws.String(resource.UniqueId()),
+		ClusterName:       aws.String(name),
+		ShardCapacity:     aws.Int64(int64(d.Get("shard_capacity").(int))),
+		ShardCount:        aws.Int64(int64(d.Get("shard_count").(int))),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_maintenance_window"); ok {
+		input.PreferredMaintenanceWindow = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcSecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[INFO] Creating DocDB Elastic Cluster: %s", name)
+	output, err := conn.CreateClusterWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating DocDB Elastic Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Cluster.ClusterArn))
+
+	if _, err := waitClusterActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for DocDB Elastic Cluster (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DocDBElasticConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cluster, err := FindClusterByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DocDB Elastic Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading DocDB Elastic Cluster (%s): %s", d.Id(), err)
+	}
+
+	d.Set("admin_user_name", cluster.AdminUserName)
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("auth_type", cluster.AuthType)
+	d.Set("endpoint", cluster.ClusterEndpoint)
+	d.Set("kms_key_id", cluster.KmsKeyId)
+	d.Set("name", cluster.ClusterName)
+	d.Set("preferred_maintenance_window", cluster.PreferredMaintenanceWindow)
+	d.Set("shard_capacity", cluster.ShardCapacity)
+	d.Set("shard_count", cluster.ShardCount)
+	d.Set("subnet_ids", aws.StringValueSlice(cluster.SubnetIds))
+	d.Set("vpc_security_group_ids", aws.StringValueSlice(cluster.VpcSecurityGroupIds))
+
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("listing tags for DocDB Elastic Cluster (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DocDBElasticConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &docdbelastic.UpdateClusterInput{
+			ClientToken: aws.String(resource.UniqueId()),
+			ClusterArn:  aws.String(d.Id()),
+		}
+
+		if d.HasChange("admin_user_password") {
+			input.AdminUserPassword = aws.String(d.Get("admin_user_password").(string))
+		}
+
+		if d.HasChange("auth_type") {
+			input.AuthType = aws.String(d.Get("auth_type").(string))
+		}
+
+		if d.HasChange("preferred_maintenance_window") {
+			input.PreferredMaintenanceWindow = aws.String(d.Get("preferred_maintenance_window").(string))
+		}
+
+		if d.HasChange("shard_capacity") {
+			input.ShardCapacity = aws.Int64(int64(d.Get("shard_capacity").(int)))
+		}
+
+		if d.HasChange("shard_count") {
+			input.ShardCount = aws.Int64(int64(d.Get("shard_count").(int)))
+		}
+
+		if d.HasChange("subnet_ids") {
+			input.SubnetIds = flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set))
+		}
+
+		if d.HasChange("vpc_security_group_ids") {
+			input.VpcSecurityGroupIds = flex.ExpandStringSet(d.Get("vpc_security_group_ids").(*schema.Set))
+		}
+
+		log.Printf("[INFO] Updating DocDB Elastic Cluster: %s", d.Id())
+		_, err := conn.UpdateClusterWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating DocDB Elastic Cluster (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitClusterActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for DocDB Elastic Cluster (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("updating DocDB Elastic Cluster (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceClusterRead(ctx, d, meta)
+}
+
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).DocDBElasticConn
+
+	log.Printf("[INFO] Deleting DocDB Elastic Cluster: %s", d.Id())
+	_, err := conn.DeleteClusterWithContext(ctx, &docdbelastic.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting DocDB Elastic Cluster (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for DocDB Elastic Cluster (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindClusterByARN(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) (*docdbelastic.Cluster, error) {
+	input := &docdbelastic.GetClusterInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetClusterWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, docdbelastic.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Cluster == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Cluster, nil
+}
+
+func statusCluster(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitClusterActive(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			docdbelastic.StatusCreating,
+			docdbelastic.StatusUpdating,
+			docdbelastic.StatusModifying,
+			docdbelastic.StatusMerging,
+			docdbelastic.StatusSplitting,
+		},
+		Target:     []string{docdbelastic.StatusActive},
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitClusterDeleted(ctx context.Context, conn *docdbelastic.DocDBElastic, arn string, timeout time.Duration) (*docdbelastic.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{docdbelastic.StatusDeleting},
+		Target:     []string{},
+		Refresh:    statusCluster(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*docdbelastic.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -339,6 +339,11 @@ func resourceEBSVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff
 	} else {
 		// Update.
 
+		// Multi-Attach cannot be disabled, so the volume must remain io1 or io2.
+		if diff.HasChange("type") && multiAttachEnabled && volumeType != ec2.VolumeTypeIo1 && volumeType != ec2.VolumeTypeIo2 {
+			return fmt.Errorf("'type' cannot be changed to '%s' when 'multi_attach_enabled' is set", volumeType)
+		}
+
 		// Setting 'iops = 0' is a no-op if the volume type does not require Iops to be specified.
 		if diff.HasChange("iops") && volumeType != ec2.VolumeTypeIo1 && volumeType != ec2.VolumeTypeIo2 && iops == 0 {
 			return diff.Clear("iops")
@@ -457,6 +457,33 @@ func TestAccEC2EBSVolume_multiAttach_gp2(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_multiAttach_typeChange(t *testing.T) {
+	var v ec2.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_multiAttach(rName, "io2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "type", "io2"),
+				),
+			},
+			{
+				Config:      testAccEBSVolumeConfig_multiAttach(rName, "gp3"),
+				ExpectError: regexp.MustCompile(`'type' cannot be changed to 'gp3' when 'multi_attach_enabled' is set`),
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSVolume_outpost(t *testing.T) {
 	var v ec2.Volume
 	outpostDataSourceName := "data.aws_outposts_outpost.test"
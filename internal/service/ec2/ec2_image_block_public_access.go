@@ -0,0 +1,110 @@
+package ec2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceImageBlockPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImageBlockPublicAccessCreate,
+		Read:   resourceImageBlockPublicAccessRead,
+		Update: resourceImageBlockPublicAccessUpdate,
+		Delete: resourceImageBlockPublicAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"state": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing,
+					ec2.ImageBlockPublicAccessDisabledStateUnblocked,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceImageBlockPublicAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(conn, state, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error setting EC2 Image Block Public Access (%s): %w", state, err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	return resourceImageBlockPublicAccessRead(d, meta)
+}
+
+func resourceImageBlockPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state, err := FindImageBlockPublicAccessState(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Image Block Public Access: %w", err)
+	}
+
+	d.Set("state", state)
+
+	return nil
+}
+
+func resourceImageBlockPublicAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	state := d.Get("state").(string)
+	if err := setImageBlockPublicAccessState(conn, state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating EC2 Image Block Public Access (%s): %w", state, err)
+	}
+
+	return resourceImageBlockPublicAccessRead(d, meta)
+}
+
+func resourceImageBlockPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Removing the resource unblocks public sharing of AMIs.
+	if err := setImageBlockPublicAccessState(conn, ec2.ImageBlockPublicAccessDisabledStateUnblocked, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error disabling EC2 Image Block Public Access: %w", err)
+	}
+
+	return nil
+}
+
+func setImageBlockPublicAccessState(conn *ec2.EC2, state string, timeout time.Duration) error {
+	var err error
+
+	if state == ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing {
+		_, err = conn.EnableImageBlockPublicAccess(&ec2.EnableImageBlockPublicAccessInput{
+			ImageBlockPublicAccessState: aws.String(state),
+		})
+	} else {
+		_, err = conn.DisableImageBlockPublicAccess(&ec2.DisableImageBlockPublicAccessInput{})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Changes to the setting can take up to 10 minutes to take effect.
+	return WaitImageBlockPublicAccessState(conn, state, timeout)
+}
@@ -0,0 +1,96 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestAccEC2ImageBlockPublicAccess_basic(t *testing.T) {
+	resourceName := "aws_ec2_image_block_public_access.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckImageBlockPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccess(resourceName, ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImageBlockPublicAccessConfig_basic(ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageBlockPublicAccess(resourceName, ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.ImageBlockPublicAccessDisabledStateUnblocked),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageBlockPublicAccessDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	state, err := tfec2.FindImageBlockPublicAccessState(conn)
+
+	if err != nil {
+		return err
+	}
+
+	if state != ec2.ImageBlockPublicAccessDisabledStateUnblocked {
+		return fmt.Errorf("Image block public access not unblocked on resource removal")
+	}
+
+	return nil
+}
+
+func testAccCheckImageBlockPublicAccess(n, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		state, err := tfec2.FindImageBlockPublicAccessState(conn)
+
+		if err != nil {
+			return err
+		}
+
+		if state != expected {
+			return fmt.Errorf("Image block public access is not in expected state (%s), got: %s", expected, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccImageBlockPublicAccessConfig_basic(state string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_image_block_public_access" "test" {
+  state = %[1]q
+}
+`, state)
+}
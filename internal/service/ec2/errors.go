@@ -37,6 +37,7 @@ const (
 	errCodeInvalidGatewayIDNotFound                       = "InvalidGatewayID.NotFound"
 	errCodeInvalidGroupNotFound                           = "InvalidGroup.NotFound"
 	errCodeInvalidHostIDNotFound                          = "InvalidHostID.NotFound"
+	errCodeInvalidInstanceConnectEndpointIDNotFound       = "InvalidInstanceConnectEndpointId.NotFound"
 	errCodeInvalidInstanceIDNotFound                      = "InvalidInstanceID.NotFound"
 	errCodeInvalidInternetGatewayIDNotFound               = "InvalidInternetGatewayID.NotFound"
 	errCodeInvalidKeyPairNotFound                         = "InvalidKeyPair.NotFound"
@@ -80,6 +81,10 @@ const (
 	errCodeInvalidVPCEndpointServiceIDNotFound            = "InvalidVpcEndpointServiceId.NotFound"
 	errCodeInvalidVPCIDNotFound                           = "InvalidVpcID.NotFound"
 	errCodeInvalidVPCPeeringConnectionIDNotFound          = "InvalidVpcPeeringConnectionID.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIDNotFound        = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIDNotFound           = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIDNotFound        = "InvalidVerifiedAccessInstanceId.NotFound"
+	errCodeInvalidVerifiedAccessTrustProviderIDNotFound   = "InvalidVerifiedAccessTrustProviderId.NotFound"
 	errCodeInvalidVPNConnectionIDNotFound                 = "InvalidVpnConnectionID.NotFound"
 	errCodeInvalidVPNGatewayAttachmentNotFound            = "InvalidVpnGatewayAttachment.NotFound"
 	errCodeInvalidVPNGatewayIDNotFound                    = "InvalidVpnGatewayID.NotFound"
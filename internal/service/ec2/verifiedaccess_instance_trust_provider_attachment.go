@@ -0,0 +1,117 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceVerifiedAccessInstanceTrustProviderAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVerifiedAccessInstanceTrustProviderAttachmentCreate,
+		Read:   resourceVerifiedAccessInstanceTrustProviderAttachmentRead,
+		Delete: resourceVerifiedAccessInstanceTrustProviderAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"verifiedaccess_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"verifiedaccess_trust_provider_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID := d.Get("verifiedaccess_instance_id").(string)
+	trustProviderID := d.Get("verifiedaccess_trust_provider_id").(string)
+	id := VerifiedAccessInstanceTrustProviderAttachmentCreateID(instanceID, trustProviderID)
+	input := &ec2.AttachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(resource.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(instanceID),
+		VerifiedAccessTrustProviderId: aws.String(trustProviderID),
+	}
+
+	log.Printf("[DEBUG] Creating Verified Access Instance Trust Provider Attachment: %s", input)
+	_, err := conn.AttachVerifiedAccessTrustProvider(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Verified Access Instance Trust Provider Attachment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceVerifiedAccessInstanceTrustProviderAttachmentRead(d, meta)
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID, trustProviderID, err := VerifiedAccessInstanceTrustProviderAttachmentParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = FindVerifiedAccessInstanceTrustProviderAttachmentExists(conn, instanceID, trustProviderID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Verified Access Instance Trust Provider Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Verified Access Instance Trust Provider Attachment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("verifiedaccess_instance_id", instanceID)
+	d.Set("verifiedaccess_trust_provider_id", trustProviderID)
+
+	return nil
+}
+
+func resourceVerifiedAccessInstanceTrustProviderAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	instanceID, trustProviderID, err := VerifiedAccessInstanceTrustProviderAttachmentParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Verified Access Instance Trust Provider Attachment: %s", d.Id())
+	_, err = conn.DetachVerifiedAccessTrustProvider(&ec2.DetachVerifiedAccessTrustProviderInput{
+		ClientToken:                   aws.String(resource.UniqueId()),
+		VerifiedAccessInstanceId:      aws.String(instanceID),
+		VerifiedAccessTrustProviderId: aws.String(trustProviderID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVerifiedAccessInstanceIDNotFound, errCodeInvalidVerifiedAccessTrustProviderIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Verified Access Instance Trust Provider Attachment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -95,6 +96,48 @@ func TestAccVPCDefaultSecurityGroup_VPC_empty(t *testing.T) {
 	})
 }
 
+func TestAccVPCDefaultSecurityGroup_VPC_prefixListEgress(t *testing.T) {
+	var group ec2.SecurityGroup
+	resourceName := "aws_default_security_group.test"
+	prefixListResourceName := "aws_ec2_managed_prefix_list.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDefaultSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDefaultSecurityGroupConfig_prefixListEgress(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDefaultSecurityGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"protocol":          "tcp",
+						"from_port":         "443",
+						"to_port":           "443",
+						"description":       "HTTPS to prefix list",
+						"prefix_list_ids.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "egress.*.prefix_list_ids.*", prefixListResourceName, "id"),
+				),
+			},
+			{
+				Config:   testAccVPCDefaultSecurityGroupConfig_prefixListEgress(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"revoke_rules_on_delete"},
+			},
+		},
+	})
+}
+
 func TestAccVPCDefaultSecurityGroup_Classic_basic(t *testing.T) {
 	var group ec2.SecurityGroup
 	resourceName := "aws_default_security_group.test"
@@ -280,6 +323,44 @@ resource "aws_default_security_group" "test" {
 }
 `
 
+func testAccVPCDefaultSecurityGroupConfig_prefixListEgress(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  address_family = "IPv4"
+  max_entries    = 1
+  name           = %[1]q
+
+  entry {
+    cidr = "10.0.0.0/8"
+  }
+}
+
+resource "aws_default_security_group" "test" {
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    protocol        = "tcp"
+    from_port       = 443
+    to_port         = 443
+    description     = "HTTPS to prefix list"
+    prefix_list_ids = [aws_ec2_managed_prefix_list.test.id]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 func testAccVPCDefaultSecurityGroupConfig_classic() string {
 	return acctest.ConfigCompose(
 		acctest.ConfigEC2ClassicRegionProvider(),
@@ -187,7 +187,15 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	// MaxEntries cannot be modified in the same request as the entries.
+	// Grow the list before adding entries and shrink it after removing them.
+	if o, n := d.GetChange("max_entries"); n.(int) > o.(int) {
+		if err := updateManagedPrefixListMaxEntries(conn, d.Id(), n.(int)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChangesExcept("max_entries", "tags", "tags_all") {
 		input := &ec2.ModifyManagedPrefixListInput{
 			PrefixListId: aws.String(d.Id()),
 		}
@@ -273,11 +281,6 @@ func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) e
 			}
 		}
 
-		if d.HasChange("max_entries") {
-			input.MaxEntries = aws.Int64(int64(d.Get("max_entries").(int)))
-			wait = true
-		}
-
 		_, err := conn.ModifyManagedPrefixList(input)
 
 		if err != nil {
@@ -291,6 +294,12 @@ func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if o, n := d.GetChange("max_entries"); n.(int) < o.(int) {
+		if err := updateManagedPrefixListMaxEntries(conn, d.Id(), n.(int)); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
@@ -324,6 +333,26 @@ func resourceManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+func updateManagedPrefixListMaxEntries(conn *ec2.EC2, id string, maxEntries int) error {
+	input := &ec2.ModifyManagedPrefixListInput{
+		MaxEntries:   aws.Int64(int64(maxEntries)),
+		PrefixListId: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Updating EC2 Managed Prefix List max entries: %s", input)
+	_, err := conn.ModifyManagedPrefixList(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating EC2 Managed Prefix List (%s) max entries: %w", id, err)
+	}
+
+	if _, err := WaitManagedPrefixListModified(conn, id); err != nil {
+		return fmt.Errorf("error waiting for EC2 Managed Prefix List (%s) update: %w", id, err)
+	}
+
+	return nil
+}
+
 func expandAddPrefixListEntry(tfMap map[string]interface{}) *ec2.AddPrefixListEntry {
 	if tfMap == nil {
 		return nil
@@ -0,0 +1,94 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCManagedPrefixListsDataSource_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue("data.aws_ec2_managed_prefix_lists.test", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListsDataSource_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_managed_prefix_lists.test", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCManagedPrefixListsDataSource_noMatches(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckManagedPrefixList(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCManagedPrefixListsDataSourceConfig_noMatches,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_managed_prefix_lists.test", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVPCManagedPrefixListsDataSourceConfig_basic = `
+data "aws_ec2_managed_prefix_lists" "test" {}
+`
+
+func testAccVPCManagedPrefixListsDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_managed_prefix_lists" "test" {
+  tags = {
+    Name = aws_ec2_managed_prefix_list.test.tags["Name"]
+  }
+}
+`, rName)
+}
+
+const testAccVPCManagedPrefixListsDataSourceConfig_noMatches = `
+data "aws_ec2_managed_prefix_lists" "test" {
+  filter {
+    name   = "prefix-list-name"
+    values = ["no-match"]
+  }
+}
+`
@@ -2386,3 +2386,123 @@ func WaitVolumeAttachmentAttached(conn *ec2.EC2, name, volumeID, instanceID stri
 
 	return err
 }
+
+func WaitVerifiedAccessEndpointCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{ec2.VerifiedAccessEndpointStatusCodePending},
+		Target:                    []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh:                   StatusVerifiedAccessEndpoint(conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointUpdated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{ec2.VerifiedAccessEndpointStatusCodeUpdating},
+		Target:                    []string{ec2.VerifiedAccessEndpointStatusCodeActive},
+		Refresh:                   StatusVerifiedAccessEndpoint(conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitVerifiedAccessEndpointDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VerifiedAccessEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.VerifiedAccessEndpointStatusCodeDeleting, ec2.VerifiedAccessEndpointStatusCodeActive},
+		Target:  []string{},
+		Refresh: StatusVerifiedAccessEndpoint(conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.VerifiedAccessEndpoint); ok {
+		if status := output.Status; status != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInstanceConnectEndpointCreated(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateCreateInProgress},
+		Target:  []string{ec2.Ec2InstanceConnectEndpointStateCreateComplete},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitInstanceConnectEndpointDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.Ec2InstanceConnectEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.Ec2InstanceConnectEndpointStateDeleteInProgress},
+		Target:  []string{},
+		Refresh: StatusInstanceConnectEndpointState(conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.Ec2InstanceConnectEndpoint); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitImageBlockPublicAccessState(conn *ec2.EC2, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ImageBlockPublicAccessEnabledStateBlockNewSharing, ec2.ImageBlockPublicAccessDisabledStateUnblocked},
+		Target:  []string{target},
+		Refresh: StatusImageBlockPublicAccessState(conn),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
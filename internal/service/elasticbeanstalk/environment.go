@@ -1,6 +1,7 @@
 package elasticbeanstalk
 
 import ( // nosemgrep: aws-sdk-go-multiple-service-imports
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,6 +14,7 @@ import ( // nosemgrep: aws-sdk-go-multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -56,7 +58,10 @@ func ResourceEnvironment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffValidateManagedActions,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -98,6 +103,11 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"operations_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"tier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -283,6 +293,10 @@ func resourceEnvironmentCreate(d *schema.ResourceData, meta interface{}) error {
 		createOpts.VersionLabel = aws.String(version)
 	}
 
+	if v, ok := d.GetOk("operations_role"); ok {
+		createOpts.OperationsRole = aws.String(v.(string))
+	}
+
 	// Get the current time to filter getBeanstalkEnvironmentErrors messages
 	t := time.Now()
 	log.Printf("[DEBUG] Elastic Beanstalk Environment create opts: %s", createOpts)
@@ -425,8 +439,53 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("version_label") {
-		hasChange = true
-		updateOpts.VersionLabel = aws.String(d.Get("version_label").(string))
+		// An empty version label is rejected by the API; removing version_label
+		// from configuration leaves the currently deployed version in place.
+		if v, ok := d.GetOk("version_label"); ok {
+			hasChange = true
+			updateOpts.VersionLabel = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("operations_role") {
+		// Get the current time to filter getBeanstalkEnvironmentErrors messages
+		t := time.Now()
+
+		if v, ok := d.GetOk("operations_role"); ok {
+			input := &elasticbeanstalk.AssociateEnvironmentOperationsRoleInput{
+				EnvironmentName: aws.String(d.Get("name").(string)),
+				OperationsRole:  aws.String(v.(string)),
+			}
+
+			log.Printf("[DEBUG] Associating Elastic Beanstalk Environment (%s) operations role: %s", d.Id(), input)
+			if _, err := conn.AssociateEnvironmentOperationsRole(input); err != nil {
+				return fmt.Errorf("error associating Elastic Beanstalk Environment (%s) operations role: %w", d.Id(), err)
+			}
+		} else {
+			input := &elasticbeanstalk.DisassociateEnvironmentOperationsRoleInput{
+				EnvironmentName: aws.String(d.Get("name").(string)),
+			}
+
+			log.Printf("[DEBUG] Disassociating Elastic Beanstalk Environment (%s) operations role", d.Id())
+			if _, err := conn.DisassociateEnvironmentOperationsRole(input); err != nil {
+				return fmt.Errorf("error disassociating Elastic Beanstalk Environment (%s) operations role: %w", d.Id(), err)
+			}
+		}
+
+		waitForReadyTimeOut, err := time.ParseDuration(d.Get("wait_for_ready_timeout").(string))
+		if err != nil {
+			return err
+		}
+		pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+		if err != nil {
+			pollInterval = 0
+			log.Printf("[WARN] Error parsing poll_interval, using default backoff")
+		}
+
+		err = waitForEnvironmentReady(conn, d.Id(), waitForReadyTimeOut, pollInterval, t)
+		if err != nil {
+			return fmt.Errorf("error waiting for Elastic Beanstalk Environment (%s) to become ready: %w", d.Id(), err)
+		}
 	}
 
 	if hasChange {
@@ -563,8 +622,13 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("version_label", env.VersionLabel); err != nil {
-		return err
+	// While a rolling deployment is in progress the environment still reports the
+	// previously deployed version label. Keep the known value until the
+	// deployment completes so that refreshes don't produce a spurious diff.
+	if aws.StringValue(env.Status) != elasticbeanstalk.EnvironmentStatusUpdating || d.Get("version_label").(string) == "" {
+		if err := d.Set("version_label", env.VersionLabel); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("tier", env.Tier.Name); err != nil {
@@ -621,6 +685,9 @@ func resourceEnvironmentRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("endpoint_url", env.EndpointURL); err != nil {
 		return err
 	}
+	if err := d.Set("operations_role", env.OperationsRole); err != nil {
+		return err
+	}
 
 	tags, err := ListTags(conn, arn)
 
@@ -964,6 +1031,57 @@ func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptio
 	return settings
 }
 
+const (
+	managedActionsNamespace               = "aws:elasticbeanstalk:managedactions"
+	managedActionsPlatformUpdateNamespace = "aws:elasticbeanstalk:managedactions:platformupdate"
+)
+
+var managedActionsPreferredStartTimeRegexp = regexp.MustCompile(`(?i)^(mon|tue|wed|thu|fri|sat|sun):([01]\d|2[0-3]):[0-5]\d$`)
+
+// customizeDiffValidateManagedActions validates the managed platform update
+// settings, which are otherwise only rejected by the API after a lengthy
+// environment update.
+func customizeDiffValidateManagedActions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("setting") {
+		return nil
+	}
+
+	managedActions := map[string]string{}
+	for _, setting := range extractOptionSettings(diff.Get("setting").(*schema.Set)) {
+		switch namespace := aws.StringValue(setting.Namespace); namespace {
+		case managedActionsNamespace, managedActionsPlatformUpdateNamespace:
+			managedActions[namespace+":"+aws.StringValue(setting.OptionName)] = aws.StringValue(setting.Value)
+		}
+	}
+
+	for _, key := range []string{managedActionsNamespace + ":ManagedActionsEnabled", managedActionsPlatformUpdateNamespace + ":InstanceRefreshEnabled"} {
+		if v, ok := managedActions[key]; ok && v != "" && !strings.EqualFold(v, "true") && !strings.EqualFold(v, "false") {
+			return fmt.Errorf("setting %q must be one of \"true\" or \"false\", got: %s", key, v)
+		}
+	}
+
+	updateLevel := managedActions[managedActionsPlatformUpdateNamespace+":UpdateLevel"]
+	if updateLevel != "" && updateLevel != "minor" && updateLevel != "patch" {
+		return fmt.Errorf("setting %q must be one of \"minor\" or \"patch\", got: %s", managedActionsPlatformUpdateNamespace+":UpdateLevel", updateLevel)
+	}
+
+	preferredStartTime := managedActions[managedActionsNamespace+":PreferredStartTime"]
+	if preferredStartTime != "" && !managedActionsPreferredStartTimeRegexp.MatchString(preferredStartTime) {
+		return fmt.Errorf("setting %q must be in the format day:hour:minute (e.g. Sun:10:00), got: %s", managedActionsNamespace+":PreferredStartTime", preferredStartTime)
+	}
+
+	if strings.EqualFold(managedActions[managedActionsNamespace+":ManagedActionsEnabled"], "true") {
+		if preferredStartTime == "" {
+			return fmt.Errorf("setting %q is required when managed actions are enabled", managedActionsNamespace+":PreferredStartTime")
+		}
+		if updateLevel == "" {
+			return fmt.Errorf("setting %q is required when managed actions are enabled", managedActionsPlatformUpdateNamespace+":UpdateLevel")
+		}
+	}
+
+	return nil
+}
+
 func dropGeneratedSecurityGroup(settingValue string, meta interface{}) string {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
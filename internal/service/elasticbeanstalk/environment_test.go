@@ -435,6 +435,52 @@ func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_BeanstalkEnv_operationsRole(t *testing.T) {
+	var app elasticbeanstalk.EnvironmentDescription
+
+	resourceName := "aws_elastic_beanstalk_environment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBeanstalkEnvDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBeanstalkEnvConfig_operationsRole(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBeanstalkEnvExists(resourceName, &app),
+					resource.TestCheckResourceAttrPair(resourceName, "operations_role", "aws_iam_role.operations_role", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"setting",
+					"wait_for_ready_timeout",
+				},
+			},
+			{
+				Config: testAccBeanstalkEnvConfig_operationsRole(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBeanstalkEnvExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "operations_role", ""),
+				),
+			},
+			{
+				Config: testAccBeanstalkEnvConfig_operationsRole(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBeanstalkEnvExists(resourceName, &app),
+					resource.TestCheckResourceAttrPair(resourceName, "operations_role", "aws_iam_role.operations_role", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVerifyBeanstalkConfig(env *elasticbeanstalk.EnvironmentDescription, expected []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if env == nil {
@@ -865,6 +911,81 @@ resource "aws_elastic_beanstalk_environment" "test" {
 `, rName)
 }
 
+func testAccBeanstalkEnvConfig_operationsRole(rName string, associate bool) string {
+	operationsRole := "null"
+	if associate {
+		operationsRole = "aws_iam_role.operations_role.arn"
+	}
+
+	return testAccBeanstalkEnvConfigBase(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "operations_role" {
+  name = "%[1]s-operations"
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "operations_role-AdministratorAccess-AWSElasticBeanstalk" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AdministratorAccess-AWSElasticBeanstalk"
+  role       = aws_iam_role.operations_role.id
+}
+
+resource "aws_elastic_beanstalk_environment" "test" {
+  depends_on = [aws_iam_role_policy_attachment.operations_role-AdministratorAccess-AWSElasticBeanstalk]
+
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+  operations_role     = %[2]s
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, rName, operationsRole)
+}
+
 func testAccBeanstalkEnvConfig_platform_arn(rName string) string {
 	return testAccBeanstalkEnvConfigBase(rName) + fmt.Sprintf(`
 resource "aws_elastic_beanstalk_environment" "test" {
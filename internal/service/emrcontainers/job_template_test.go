@@ -0,0 +1,341 @@
+package emrcontainers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemrcontainers "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEMRContainersJobTemplate_basic(t *testing.T) {
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_template_data.0.execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_sql_job_driver.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.0.entry_point", "default"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.release_label", "emr-6.10.0-latest"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_disappears(t *testing.T) {
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfemrcontainers.ResourceJobTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_configurationOverrides(t *testing.T) {
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfigConfigurationOverrides(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.0.classification", "spark-defaults"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.application_configuration.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.cloud_watch_monitoring_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.cloud_watch_monitoring_configuration.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.configuration_overrides.0.monitoring_configuration.0.persistent_app_ui", emrcontainers.PersistentAppUIEnabled),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_driver.0.spark_submit_job_driver.0.entry_point_arguments.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "job_template_data.0.job_tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEMRContainersJobTemplate_tags(t *testing.T) {
+	var v emrcontainers.JobTemplate
+	rName := sdkacctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_emrcontainers_job_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, emrcontainers.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckJobTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTemplateConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobTemplateConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccJobTemplateConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobTemplateExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobTemplateExists(n string, v *emrcontainers.JobTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EMR Containers Job Template ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+		output, err := tfemrcontainers.FindJobTemplateByID(context.TODO(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobTemplateDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRContainersConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_emrcontainers_job_template" {
+			continue
+		}
+
+		_, err := tfemrcontainers.FindJobTemplateByID(context.TODO(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EMR Containers Job Template %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccJobTemplateBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticmapreduce.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobTemplateConfig(rName string) string {
+	return acctest.ConfigCompose(testAccJobTemplateBase(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccJobTemplateConfigConfigurationOverrides(rName string) string {
+	return acctest.ConfigCompose(testAccJobTemplateBase(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    configuration_overrides {
+      application_configuration {
+        classification = "spark-defaults"
+
+        properties = {
+          "spark.driver.memory" = "2G"
+        }
+      }
+
+      monitoring_configuration {
+        persistent_app_ui = "ENABLED"
+
+        cloud_watch_monitoring_configuration {
+          log_group_name = aws_cloudwatch_log_group.test.name
+        }
+      }
+    }
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+        entry_point_arguments   = ["--arg1", "value1"]
+        spark_submit_parameters = "--conf spark.executor.instances=2"
+      }
+    }
+
+    job_tags = {
+      team = "data"
+    }
+  }
+
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccJobTemplateConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateBase(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJobTemplateConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccJobTemplateBase(rName), fmt.Sprintf(`
+resource "aws_emrcontainers_job_template" "test" {
+  job_template_data {
+    execution_role_arn = aws_iam_role.test.arn
+    release_label      = "emr-6.10.0-latest"
+
+    job_driver {
+      spark_submit_job_driver {
+        entry_point = "default"
+      }
+    }
+  }
+
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
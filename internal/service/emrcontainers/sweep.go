@@ -15,6 +15,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_emrcontainers_job_template", &resource.Sweeper{
+		Name: "aws_emrcontainers_job_template",
+		F:    sweepJobTemplates,
+	})
+
 	resource.AddTestSweepers("aws_emrcontainers_virtual_cluster", &resource.Sweeper{
 		Name: "aws_emrcontainers_virtual_cluster",
 		F:    sweepVirtualClusters,
@@ -67,3 +72,46 @@ func sweepVirtualClusters(region string) error {
 
 	return nil
 }
+
+func sweepJobTemplates(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EMRContainersConn
+	input := &emrcontainers.ListJobTemplatesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListJobTemplatesPages(input, func(page *emrcontainers.ListJobTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Templates {
+			r := ResourceJobTemplate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EMR Containers Job Template sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EMR Containers Job Templates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EMR Containers Job Templates (%s): %w", region, err)
+	}
+
+	return nil
+}
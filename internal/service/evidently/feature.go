@@ -0,0 +1,576 @@
+package evidently
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeatureCreate,
+		ReadWithoutTimeout:   resourceFeatureRead,
+		UpdateWithoutTimeout: resourceFeatureUpdate,
+		DeleteWithoutTimeout: resourceFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_variation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"entity_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 127),
+				},
+			},
+			"evaluation_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"evaluation_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchevidently.FeatureEvaluationStrategy_Values(), false),
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"project": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"value_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"variations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 127),
+						},
+						"value": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								// Values are strings so that false and 0 can be distinguished from unset.
+								Schema: map[string]*schema.Schema{
+									"bool_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+									},
+									"double_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidTypeStringNullableFloat,
+									},
+									"long_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^-?[0-9]+$`), "must be an integer"),
+									},
+									"string_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	variations, err := expandVariationConfigs(d.Get("variations").(*schema.Set).List())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	project := d.Get("project").(string)
+	input := &cloudwatchevidently.CreateFeatureInput{
+		Name:       aws.String(name),
+		Project:    aws.String(project),
+		Variations: variations,
+	}
+
+	if v, ok := d.GetOk("default_variation"); ok {
+		input.DefaultVariation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entity_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		input.EntityOverrides = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("evaluation_strategy"); ok {
+		input.EvaluationStrategy = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating CloudWatch Evidently Feature: %s", input)
+	output, err := conn.CreateFeatureWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Evidently Feature (%s) in Project (%s): %s", name, project, err)
+	}
+
+	d.SetId(aws.StringValue(output.Feature.Arn))
+
+	if _, err := waitFeatureAvailable(ctx, conn, name, project, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Feature (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceFeatureRead(ctx, d, meta)
+}
+
+func resourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	featureName, projectName, err := FeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature, err := FindFeatureByTwoPartKey(ctx, conn, featureName, projectName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", feature.Arn)
+	d.Set("created_time", aws.TimeValue(feature.CreatedTime).Format(time.RFC3339))
+	d.Set("default_variation", feature.DefaultVariation)
+	d.Set("description", feature.Description)
+	d.Set("entity_overrides", aws.StringValueMap(feature.EntityOverrides))
+	if err := d.Set("evaluation_rules", flattenEvaluationRules(feature.EvaluationRules)); err != nil {
+		return diag.Errorf("setting evaluation_rules: %s", err)
+	}
+	d.Set("evaluation_strategy", feature.EvaluationStrategy)
+	d.Set("last_updated_time", aws.TimeValue(feature.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", feature.Name)
+	// The API returns the project ARN; keep the configured name or ARN unless importing.
+	if _, ok := d.GetOk("project"); !ok {
+		d.Set("project", projectName)
+	}
+	d.Set("status", feature.Status)
+	d.Set("value_type", feature.ValueType)
+	if err := d.Set("variations", flattenVariations(feature.Variations)); err != nil {
+		return diag.Errorf("setting variations: %s", err)
+	}
+
+	tags := KeyValueTags(feature.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	featureName, projectName, err := FeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cloudwatchevidently.UpdateFeatureInput{
+			DefaultVariation:   aws.String(d.Get("default_variation").(string)),
+			Description:        aws.String(d.Get("description").(string)),
+			EntityOverrides:    flex.ExpandStringMap(d.Get("entity_overrides").(map[string]interface{})),
+			EvaluationStrategy: aws.String(d.Get("evaluation_strategy").(string)),
+			Feature:            aws.String(featureName),
+			Project:            aws.String(projectName),
+		}
+
+		if d.HasChange("variations") {
+			o, n := d.GetChange("variations")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			variations, err := expandVariationConfigs(ns.Difference(os).List())
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			input.AddOrUpdateVariations = variations
+
+			// Variations which are only renamed or removed must be explicitly removed.
+			newNames := map[string]bool{}
+			for _, tfMapRaw := range ns.List() {
+				newNames[tfMapRaw.(map[string]interface{})["name"].(string)] = true
+			}
+
+			for _, tfMapRaw := range os.List() {
+				if name := tfMapRaw.(map[string]interface{})["name"].(string); !newNames[name] {
+					input.RemoveVariations = append(input.RemoveVariations, aws.String(name))
+				}
+			}
+		}
+
+		log.Printf("[INFO] Updating CloudWatch Evidently Feature: %s", input)
+		_, err := conn.UpdateFeatureWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFeatureAvailable(ctx, conn, featureName, projectName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for CloudWatch Evidently Feature (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Feature (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFeatureRead(ctx, d, meta)
+}
+
+func resourceFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	featureName, projectName, err := FeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting CloudWatch Evidently Feature: %s", d.Id())
+	_, err = conn.DeleteFeatureWithContext(ctx, &cloudwatchevidently.DeleteFeatureInput{
+		Feature: aws.String(featureName),
+		Project: aws.String(projectName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFeatureDeleted(ctx, conn, featureName, projectName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Feature (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindFeatureByTwoPartKey(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string) (*cloudwatchevidently.Feature, error) {
+	input := &cloudwatchevidently.GetFeatureInput{
+		Feature: aws.String(featureName),
+		Project: aws.String(projectNameOrARN),
+	}
+
+	output, err := conn.GetFeatureWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Feature == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Feature, nil
+}
+
+// FeatureParseResourceID returns the feature and project names from a feature ARN,
+// e.g. arn:aws:evidently:us-east-1:123456789012:project/example/feature/example.
+func FeatureParseResourceID(id string) (string, string, error) {
+	return parseProjectScopedARN(id, "feature")
+}
+
+func parseProjectScopedARN(id, resourceType string) (string, string, error) {
+	parsedARN, err := arn.Parse(id)
+
+	if err == nil {
+		parts := strings.Split(parsedARN.Resource, "/")
+
+		if len(parts) == 4 && parts[0] == "project" && parts[1] != "" && parts[2] == resourceType && parts[3] != "" {
+			return parts[3], parts[1], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected an ARN with resource project/PROJECT-NAME/%[2]s/%[3]s-NAME", id, resourceType, strings.ToUpper(resourceType))
+}
+
+func expandVariableValue(tfMap map[string]interface{}) (*cloudwatchevidently.VariableValue, error) {
+	apiObject := &cloudwatchevidently.VariableValue{}
+	n := 0
+
+	if v, ok := tfMap["bool_value"].(string); ok && v != "" {
+		b, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.BoolValue = aws.Bool(b)
+		n++
+	}
+
+	if v, ok := tfMap["double_value"].(string); ok && v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.DoubleValue = aws.Float64(f)
+		n++
+	}
+
+	if v, ok := tfMap["long_value"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.LongValue = aws.Int64(i)
+		n++
+	}
+
+	if v, ok := tfMap["string_value"].(string); ok && v != "" {
+		apiObject.StringValue = aws.String(v)
+		n++
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of bool_value, double_value, long_value or string_value must be set")
+	}
+
+	return apiObject, nil
+}
+
+func expandVariationConfigs(tfList []interface{}) ([]*cloudwatchevidently.VariationConfig, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	var apiObjects []*cloudwatchevidently.VariationConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchevidently.VariationConfig{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			value, err := expandVariableValue(v[0].(map[string]interface{}))
+
+			if err != nil {
+				return nil, fmt.Errorf("variation (%s): %w", aws.StringValue(apiObject.Name), err)
+			}
+
+			apiObject.Value = value
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func flattenEvaluationRules(apiObjects []*cloudwatchevidently.EvaluationRule) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVariableValue(apiObject *cloudwatchevidently.VariableValue) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BoolValue; v != nil {
+		tfMap["bool_value"] = strconv.FormatBool(aws.BoolValue(v))
+	}
+
+	if v := apiObject.DoubleValue; v != nil {
+		tfMap["double_value"] = strconv.FormatFloat(aws.Float64Value(v), 'f', -1, 64)
+	}
+
+	if v := apiObject.LongValue; v != nil {
+		tfMap["long_value"] = strconv.FormatInt(aws.Int64Value(v), 10)
+	}
+
+	if v := apiObject.StringValue; v != nil {
+		tfMap["string_value"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenVariations(apiObjects []*cloudwatchevidently.Variation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":  aws.StringValue(apiObject.Name),
+			"value": []interface{}{flattenVariableValue(apiObject.Value)},
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,325 @@
+package evidently_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevidently "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEvidentlyFeature_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_feature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "evidently", regexp.MustCompile(`project/.+/feature/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "default_variation", "Variation1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "entity_overrides.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_strategy", cloudwatchevidently.FeatureEvaluationStrategyAllRules),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project", "aws_evidently_project.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudwatchevidently.FeatureStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "value_type", cloudwatchevidently.VariationValueTypeString),
+					resource.TestCheckResourceAttr(resourceName, "variations.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "variations.*", map[string]string{
+						"name":                 "Variation1",
+						"value.#":              "1",
+						"value.0.string_value": "test",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyFeature_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_feature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfevidently.ResourceFeature(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyFeature_variations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_feature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "variations.#", "1"),
+				),
+			},
+			{
+				Config: testAccFeatureConfig_variations(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_variation", "Variation2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "entity_overrides.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity_overrides.test1", "Variation1"),
+					resource.TestCheckResourceAttr(resourceName, "variations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "variations.*", map[string]string{
+						"name":                 "Variation1",
+						"value.0.string_value": "test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "variations.*", map[string]string{
+						"name":                 "Variation2",
+						"value.0.string_value": "test2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyFeature_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_feature.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeatureConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccFeatureConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFeatureDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_evidently_feature" {
+			continue
+		}
+
+		featureName, projectName, err := tfevidently.FeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfevidently.FindFeatureByTwoPartKey(context.Background(), conn, featureName, projectName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Evidently Feature %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFeatureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Evidently Feature ID is set")
+		}
+
+		featureName, projectName, err := tfevidently.FeatureParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+		_, err = tfevidently.FindFeatureByTwoPartKey(context.Background(), conn, featureName, projectName)
+
+		return err
+	}
+}
+
+func testAccFeatureConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_evidently_project" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccFeatureConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFeatureConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+
+    value {
+      string_value = "test"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFeatureConfig_variations(rName string) string {
+	return acctest.ConfigCompose(testAccFeatureConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name              = %[1]q
+  project           = aws_evidently_project.test.name
+  default_variation = "Variation2"
+  description       = "updated"
+
+  entity_overrides = {
+    test1 = "Variation1"
+  }
+
+  variations {
+    name = "Variation1"
+
+    value {
+      string_value = "test"
+    }
+  }
+
+  variations {
+    name = "Variation2"
+
+    value {
+      string_value = "test2"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFeatureConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccFeatureConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+
+    value {
+      string_value = "test"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccFeatureConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccFeatureConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+
+    value {
+      string_value = "test"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,718 @@
+package evidently
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLaunch() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchCreate,
+		ReadWithoutTimeout:   resourceLaunchRead,
+		UpdateWithoutTimeout: resourceLaunchUpdate,
+		DeleteWithoutTimeout: resourceLaunchDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"execution": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ended_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 160),
+						},
+						"feature": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 127),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 127),
+						},
+						"variation": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 127),
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_monitors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_definition": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity_id_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"event_pattern": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(0, 1024),
+											validation.StringIsJSON,
+										),
+										DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+										StateFunc: func(v interface{}) string {
+											json, _ := structure.NormalizeJsonString(v)
+											return json
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"unit_label": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"value_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"project": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 2048),
+			},
+			"randomization_salt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 127),
+			},
+			"scheduled_splits_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"steps": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 6,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_weights": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 100000),
+										},
+									},
+									"segment_overrides": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"evaluation_order": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"segment": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(0, 2048),
+												},
+												"weights": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeInt,
+														ValidateFunc: validation.IntBetween(0, 100000),
+													},
+												},
+											},
+										},
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLaunchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	project := d.Get("project").(string)
+	input := &cloudwatchevidently.CreateLaunchInput{
+		Groups:  expandLaunchGroupConfigs(d.Get("groups").([]interface{})),
+		Name:    aws.String(name),
+		Project: aws.String(project),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metric_monitors"); ok && len(v.([]interface{})) > 0 {
+		input.MetricMonitors = expandMetricMonitorConfigs(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("randomization_salt"); ok {
+		input.RandomizationSalt = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scheduled_splits_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScheduledSplitsConfig = expandScheduledSplitsLaunchConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating CloudWatch Evidently Launch: %s", input)
+	output, err := conn.CreateLaunchWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Evidently Launch (%s) in Project (%s): %s", name, project, err)
+	}
+
+	d.SetId(aws.StringValue(output.Launch.Arn))
+
+	if _, err := waitLaunchReady(ctx, conn, name, project, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Launch (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLaunchRead(ctx, d, meta)
+}
+
+func resourceLaunchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	launchName, projectName, err := LaunchParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	launch, err := FindLaunchByTwoPartKey(ctx, conn, launchName, projectName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Launch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", launch.Arn)
+	d.Set("created_time", aws.TimeValue(launch.CreatedTime).Format(time.RFC3339))
+	d.Set("description", launch.Description)
+	if err := d.Set("execution", flattenLaunchExecution(launch.Execution)); err != nil {
+		return diag.Errorf("setting execution: %s", err)
+	}
+	if err := d.Set("groups", flattenLaunchGroups(launch.Groups)); err != nil {
+		return diag.Errorf("setting groups: %s", err)
+	}
+	d.Set("last_updated_time", aws.TimeValue(launch.LastUpdatedTime).Format(time.RFC3339))
+	if err := d.Set("metric_monitors", flattenMetricMonitors(launch.MetricMonitors)); err != nil {
+		return diag.Errorf("setting metric_monitors: %s", err)
+	}
+	d.Set("name", launch.Name)
+	// The API returns the project ARN; keep the configured name or ARN unless importing.
+	if _, ok := d.GetOk("project"); !ok {
+		d.Set("project", projectName)
+	}
+	d.Set("randomization_salt", launch.RandomizationSalt)
+	if launch.ScheduledSplitsDefinition != nil {
+		if err := d.Set("scheduled_splits_config", []interface{}{flattenScheduledSplitsLaunchDefinition(launch.ScheduledSplitsDefinition)}); err != nil {
+			return diag.Errorf("setting scheduled_splits_config: %s", err)
+		}
+	} else {
+		d.Set("scheduled_splits_config", nil)
+	}
+	d.Set("status", launch.Status)
+	d.Set("status_reason", launch.StatusReason)
+	d.Set("type", launch.Type)
+
+	tags := KeyValueTags(launch.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLaunchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	launchName, projectName, err := LaunchParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &cloudwatchevidently.UpdateLaunchInput{
+			Description:       aws.String(d.Get("description").(string)),
+			Groups:            expandLaunchGroupConfigs(d.Get("groups").([]interface{})),
+			Launch:            aws.String(launchName),
+			MetricMonitors:    expandMetricMonitorConfigs(d.Get("metric_monitors").([]interface{})),
+			Project:           aws.String(projectName),
+			RandomizationSalt: aws.String(d.Get("randomization_salt").(string)),
+		}
+
+		if v, ok := d.GetOk("scheduled_splits_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScheduledSplitsConfig = expandScheduledSplitsLaunchConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[INFO] Updating CloudWatch Evidently Launch: %s", input)
+		_, err := conn.UpdateLaunchWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitLaunchReady(ctx, conn, launchName, projectName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for CloudWatch Evidently Launch (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Launch (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLaunchRead(ctx, d, meta)
+}
+
+func resourceLaunchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	launchName, projectName, err := LaunchParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting CloudWatch Evidently Launch: %s", d.Id())
+	_, err = conn.DeleteLaunchWithContext(ctx, &cloudwatchevidently.DeleteLaunchInput{
+		Launch:  aws.String(launchName),
+		Project: aws.String(projectName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitLaunchDeleted(ctx, conn, launchName, projectName, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Launch (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindLaunchByTwoPartKey(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string) (*cloudwatchevidently.Launch, error) {
+	input := &cloudwatchevidently.GetLaunchInput{
+		Launch:  aws.String(launchName),
+		Project: aws.String(projectNameOrARN),
+	}
+
+	output, err := conn.GetLaunchWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Launch == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Launch, nil
+}
+
+// LaunchParseResourceID returns the launch and project names from a launch ARN,
+// e.g. arn:aws:evidently:us-east-1:123456789012:project/example/launch/example.
+func LaunchParseResourceID(id string) (string, string, error) {
+	return parseProjectScopedARN(id, "launch")
+}
+
+func expandInt64Map(m map[string]interface{}) map[string]*int64 {
+	result := make(map[string]*int64, len(m))
+
+	for k, v := range m {
+		result[k] = aws.Int64(int64(v.(int)))
+	}
+
+	return result
+}
+
+func flattenInt64Map(m map[string]*int64) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+
+	for k, v := range m {
+		result[k] = int(aws.Int64Value(v))
+	}
+
+	return result
+}
+
+func expandLaunchGroupConfigs(tfList []interface{}) []*cloudwatchevidently.LaunchGroupConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*cloudwatchevidently.LaunchGroupConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchevidently.LaunchGroupConfig{
+			Feature:   aws.String(tfMap["feature"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Variation: aws.String(tfMap["variation"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricMonitorConfigs(tfList []interface{}) []*cloudwatchevidently.MetricMonitorConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*cloudwatchevidently.MetricMonitorConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchevidently.MetricMonitorConfig{}
+
+		if v, ok := tfMap["metric_definition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			metricDefinition := &cloudwatchevidently.MetricDefinitionConfig{
+				EntityIdKey: aws.String(tfMap["entity_id_key"].(string)),
+				Name:        aws.String(tfMap["name"].(string)),
+				ValueKey:    aws.String(tfMap["value_key"].(string)),
+			}
+
+			if v, ok := tfMap["event_pattern"].(string); ok && v != "" {
+				metricDefinition.EventPattern = aws.String(v)
+			}
+
+			if v, ok := tfMap["unit_label"].(string); ok && v != "" {
+				metricDefinition.UnitLabel = aws.String(v)
+			}
+
+			apiObject.MetricDefinition = metricDefinition
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandScheduledSplitsLaunchConfig(tfMap map[string]interface{}) *cloudwatchevidently.ScheduledSplitsLaunchConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchevidently.ScheduledSplitsLaunchConfig{}
+
+	for _, tfMapRaw := range tfMap["steps"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		step := &cloudwatchevidently.ScheduledSplitConfig{
+			GroupWeights: expandInt64Map(tfMap["group_weights"].(map[string]interface{})),
+		}
+
+		if v, err := time.Parse(time.RFC3339, tfMap["start_time"].(string)); err == nil {
+			step.StartTime = aws.Time(v)
+		}
+
+		for _, tfMapRaw := range tfMap["segment_overrides"].([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			step.SegmentOverrides = append(step.SegmentOverrides, &cloudwatchevidently.SegmentOverride{
+				EvaluationOrder: aws.Int64(int64(tfMap["evaluation_order"].(int))),
+				Segment:         aws.String(tfMap["segment"].(string)),
+				Weights:         expandInt64Map(tfMap["weights"].(map[string]interface{})),
+			})
+		}
+
+		apiObject.Steps = append(apiObject.Steps, step)
+	}
+
+	return apiObject
+}
+
+func flattenLaunchExecution(apiObject *cloudwatchevidently.LaunchExecution) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EndedTime; v != nil {
+		tfMap["ended_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StartedTime; v != nil {
+		tfMap["started_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLaunchGroups(apiObjects []*cloudwatchevidently.LaunchGroup) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+		}
+
+		// Each launch group serves a single feature variation.
+		for feature, variation := range apiObject.FeatureVariations {
+			tfMap["feature"] = feature
+			tfMap["variation"] = aws.StringValue(variation)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMetricMonitors(apiObjects []*cloudwatchevidently.MetricMonitor) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.MetricDefinition == nil {
+			continue
+		}
+
+		metricDefinition := apiObject.MetricDefinition
+
+		tfList = append(tfList, map[string]interface{}{
+			"metric_definition": []interface{}{map[string]interface{}{
+				"entity_id_key": aws.StringValue(metricDefinition.EntityIdKey),
+				"event_pattern": aws.StringValue(metricDefinition.EventPattern),
+				"name":          aws.StringValue(metricDefinition.Name),
+				"unit_label":    aws.StringValue(metricDefinition.UnitLabel),
+				"value_key":     aws.StringValue(metricDefinition.ValueKey),
+			}},
+		})
+	}
+
+	return tfList
+}
+
+func flattenScheduledSplitsLaunchDefinition(apiObject *cloudwatchevidently.ScheduledSplitsLaunchDefinition) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var steps []interface{}
+
+	for _, step := range apiObject.Steps {
+		if step == nil {
+			continue
+		}
+
+		var segmentOverrides []interface{}
+
+		for _, segmentOverride := range step.SegmentOverrides {
+			if segmentOverride == nil {
+				continue
+			}
+
+			segmentOverrides = append(segmentOverrides, map[string]interface{}{
+				"evaluation_order": int(aws.Int64Value(segmentOverride.EvaluationOrder)),
+				"segment":          aws.StringValue(segmentOverride.Segment),
+				"weights":          flattenInt64Map(segmentOverride.Weights),
+			})
+		}
+
+		steps = append(steps, map[string]interface{}{
+			"group_weights":     flattenInt64Map(step.GroupWeights),
+			"segment_overrides": segmentOverrides,
+			"start_time":        aws.TimeValue(step.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	return map[string]interface{}{
+		"steps": steps,
+	}
+}
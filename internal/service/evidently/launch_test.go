@@ -0,0 +1,389 @@
+package evidently_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevidently "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEvidentlyLaunch_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_launch.test"
+	startTime := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "evidently", regexp.MustCompile(`project/.+/launch/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "groups.0.feature", "aws_evidently_feature.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "groups.0.name", "Variation1"),
+					resource.TestCheckResourceAttr(resourceName, "groups.0.variation", "Variation1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_time"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project", "aws_evidently_project.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "randomization_salt"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.group_weights.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.group_weights.Variation1", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudwatchevidently.LaunchStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", cloudwatchevidently.LaunchTypeAwsEvidentlySplits),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyLaunch_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_launch.test"
+	startTime := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfevidently.ResourceLaunch(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyLaunch_updates(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_launch.test"
+	startTime := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfig_basic(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.#", "0"),
+				),
+			},
+			{
+				Config: testAccLaunchConfig_updated(rName, startTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "groups.1.description", "second group"),
+					resource.TestCheckResourceAttr(resourceName, "groups.1.name", "Variation2"),
+					resource.TestCheckResourceAttr(resourceName, "groups.1.variation", "Variation2"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.0.metric_definition.0.entity_id_key", "entityId"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.0.metric_definition.0.name", "name1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.0.metric_definition.0.unit_label", "label"),
+					resource.TestCheckResourceAttr(resourceName, "metric_monitors.0.metric_definition.0.value_key", "details.price"),
+					resource.TestCheckResourceAttr(resourceName, "randomization_salt", "salt"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.group_weights.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.group_weights.Variation1", "30000"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_splits_config.0.steps.0.group_weights.Variation2", "70000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyLaunch_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_launch.test"
+	startTime := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudwatchevidently.EndpointsID, t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckLaunchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchConfig_tags1(rName, startTime, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLaunchConfig_tags2(rName, startTime, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccLaunchConfig_tags1(rName, startTime, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_evidently_launch" {
+			continue
+		}
+
+		launchName, projectName, err := tfevidently.LaunchParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfevidently.FindLaunchByTwoPartKey(context.Background(), conn, launchName, projectName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Evidently Launch %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLaunchExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Evidently Launch ID is set")
+		}
+
+		launchName, projectName, err := tfevidently.LaunchParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+		_, err = tfevidently.FindLaunchByTwoPartKey(context.Background(), conn, launchName, projectName)
+
+		return err
+	}
+}
+
+func testAccLaunchConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_evidently_project" "test" {
+  name = %[1]q
+}
+
+resource "aws_evidently_feature" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  variations {
+    name = "Variation1"
+
+    value {
+      string_value = "test"
+    }
+  }
+
+  variations {
+    name = "Variation2"
+
+    value {
+      string_value = "test2"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccLaunchConfig_basic(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccLaunchConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_launch" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  groups {
+    feature   = aws_evidently_feature.test.name
+    name      = "Variation1"
+    variation = "Variation1"
+  }
+
+  scheduled_splits_config {
+    steps {
+      group_weights = {
+        "Variation1" = 0
+      }
+      start_time = %[2]q
+    }
+  }
+}
+`, rName, startTime))
+}
+
+func testAccLaunchConfig_updated(rName, startTime string) string {
+	return acctest.ConfigCompose(testAccLaunchConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_launch" "test" {
+  name               = %[1]q
+  project            = aws_evidently_project.test.name
+  description        = "updated"
+  randomization_salt = "salt"
+
+  groups {
+    feature   = aws_evidently_feature.test.name
+    name      = "Variation1"
+    variation = "Variation1"
+  }
+
+  groups {
+    description = "second group"
+    feature     = aws_evidently_feature.test.name
+    name        = "Variation2"
+    variation   = "Variation2"
+  }
+
+  metric_monitors {
+    metric_definition {
+      entity_id_key = "entityId"
+      event_pattern = "{\"Price\":[{\"numeric\":[\">\",11,\"<=\",22]}]}"
+      name          = "name1"
+      unit_label    = "label"
+      value_key     = "details.price"
+    }
+  }
+
+  scheduled_splits_config {
+    steps {
+      group_weights = {
+        "Variation1" = 30000
+        "Variation2" = 70000
+      }
+      start_time = %[2]q
+    }
+  }
+}
+`, rName, startTime))
+}
+
+func testAccLaunchConfig_tags1(rName, startTime, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccLaunchConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_launch" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  groups {
+    feature   = aws_evidently_feature.test.name
+    name      = "Variation1"
+    variation = "Variation1"
+  }
+
+  scheduled_splits_config {
+    steps {
+      group_weights = {
+        "Variation1" = 0
+      }
+      start_time = %[2]q
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1))
+}
+
+func testAccLaunchConfig_tags2(rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccLaunchConfig_base(rName), fmt.Sprintf(`
+resource "aws_evidently_launch" "test" {
+  name    = %[1]q
+  project = aws_evidently_project.test.name
+
+  groups {
+    feature   = aws_evidently_feature.test.name
+    name      = "Variation1"
+    variation = "Variation1"
+  }
+
+  scheduled_splits_config {
+    steps {
+      group_weights = {
+        "Variation1" = 0
+      }
+      start_time = %[2]q
+    }
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2))
+}
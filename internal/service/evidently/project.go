@@ -0,0 +1,385 @@
+package evidently
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProjectCreate,
+		ReadWithoutTimeout:   resourceProjectRead,
+		UpdateWithoutTimeout: resourceProjectUpdate,
+		DeleteWithoutTimeout: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"active_experiment_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active_launch_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"data_delivery.0.cloudwatch_logs", "data_delivery.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+						"s3_destination": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"data_delivery.0.cloudwatch_logs", "data_delivery.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"experiment_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"feature_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cloudwatchevidently.CreateProjectInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("data_delivery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataDelivery = expandProjectDataDeliveryConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating CloudWatch Evidently Project: %s", input)
+	output, err := conn.CreateProjectWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating CloudWatch Evidently Project (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Project.Arn))
+
+	if _, err := waitProjectAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Project (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	project, err := FindProjectByNameOrARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading CloudWatch Evidently Project (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_experiment_count", project.ActiveExperimentCount)
+	d.Set("active_launch_count", project.ActiveLaunchCount)
+	d.Set("arn", project.Arn)
+	d.Set("created_time", aws.TimeValue(project.CreatedTime).Format(time.RFC3339))
+	if project.DataDelivery != nil {
+		if err := d.Set("data_delivery", []interface{}{flattenProjectDataDelivery(project.DataDelivery)}); err != nil {
+			return diag.Errorf("setting data_delivery: %s", err)
+		}
+	} else {
+		d.Set("data_delivery", nil)
+	}
+	d.Set("description", project.Description)
+	d.Set("experiment_count", project.ExperimentCount)
+	d.Set("feature_count", project.FeatureCount)
+	d.Set("last_updated_time", aws.TimeValue(project.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("launch_count", project.LaunchCount)
+	d.Set("name", project.Name)
+	d.Set("status", project.Status)
+
+	tags := KeyValueTags(project.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	if d.HasChange("description") {
+		input := &cloudwatchevidently.UpdateProjectInput{
+			Description: aws.String(d.Get("description").(string)),
+			Project:     aws.String(d.Id()),
+		}
+
+		log.Printf("[INFO] Updating CloudWatch Evidently Project: %s", input)
+		_, err := conn.UpdateProjectWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Project (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProjectAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for CloudWatch Evidently Project (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("data_delivery") {
+		input := &cloudwatchevidently.UpdateProjectDataDeliveryInput{
+			Project: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("data_delivery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			dataDelivery := expandProjectDataDeliveryConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.CloudWatchLogs = dataDelivery.CloudWatchLogs
+			input.S3Destination = dataDelivery.S3Destination
+		} else {
+			// Omitting both destinations stops data delivery.
+			input.CloudWatchLogs = &cloudwatchevidently.CloudWatchLogsDestinationConfig{}
+		}
+
+		log.Printf("[INFO] Updating CloudWatch Evidently Project data delivery: %s", input)
+		_, err := conn.UpdateProjectDataDeliveryWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Project (%s) data delivery: %s", d.Id(), err)
+		}
+
+		if _, err := waitProjectAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for CloudWatch Evidently Project (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating CloudWatch Evidently Project (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	log.Printf("[INFO] Deleting CloudWatch Evidently Project: %s", d.Id())
+	_, err := conn.DeleteProjectWithContext(ctx, &cloudwatchevidently.DeleteProjectInput{
+		Project: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting CloudWatch Evidently Project (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for CloudWatch Evidently Project (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindProjectByNameOrARN(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string) (*cloudwatchevidently.Project, error) {
+	input := &cloudwatchevidently.GetProjectInput{
+		Project: aws.String(nameOrARN),
+	}
+
+	output, err := conn.GetProjectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Project == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Project, nil
+}
+
+func expandProjectDataDeliveryConfig(tfMap map[string]interface{}) *cloudwatchevidently.ProjectDataDeliveryConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchevidently.ProjectDataDeliveryConfig{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogs = &cloudwatchevidently.CloudWatchLogsDestinationConfig{}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3Destination = &cloudwatchevidently.S3DestinationConfig{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			apiObject.S3Destination.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3Destination.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenProjectDataDelivery(apiObject *cloudwatchevidently.ProjectDataDelivery) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogs; v != nil {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.S3Destination; v != nil {
+		tfMap["s3_destination"] = []interface{}{map[string]interface{}{
+			"bucket": aws.StringValue(v.Bucket),
+			"prefix": aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
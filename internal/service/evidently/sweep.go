@@ -0,0 +1,116 @@
+//go:build sweep
+// +build sweep
+
+package evidently
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_evidently_project", &resource.Sweeper{
+		Name: "aws_evidently_project",
+		F:    sweepProjects,
+	})
+
+	resource.AddTestSweepers("aws_evidently_segment", &resource.Sweeper{
+		Name: "aws_evidently_segment",
+		F:    sweepSegments,
+		Dependencies: []string{
+			"aws_evidently_project",
+		},
+	})
+}
+
+func sweepProjects(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EvidentlyConn
+	input := &cloudwatchevidently.ListProjectsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListProjectsPages(input, func(page *cloudwatchevidently.ListProjectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Projects {
+			r := ResourceProject()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Evidently Project sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudWatch Evidently Projects (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Evidently Projects (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepSegments(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).EvidentlyConn
+	input := &cloudwatchevidently.ListSegmentsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListSegmentsPages(input, func(page *cloudwatchevidently.ListSegmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Segments {
+			r := ResourceSegment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Arn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudWatch Evidently Segment sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudWatch Evidently Segments (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Evidently Segments (%s): %w", region, err)
+	}
+
+	return nil
+}
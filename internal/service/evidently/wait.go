@@ -0,0 +1,116 @@
+package evidently
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitFeatureAvailable(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string, timeout time.Duration) (*cloudwatchevidently.Feature, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.FeatureStatusUpdating},
+		Target:  []string{cloudwatchevidently.FeatureStatusAvailable},
+		Refresh: statusFeature(ctx, conn, featureName, projectNameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFeatureDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string, timeout time.Duration) (*cloudwatchevidently.Feature, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: cloudwatchevidently.FeatureStatus_Values(),
+		Target:  []string{},
+		Refresh: statusFeature(ctx, conn, featureName, projectNameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLaunchReady(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string, timeout time.Duration) (*cloudwatchevidently.Launch, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.LaunchStatusUpdating},
+		Target: []string{
+			cloudwatchevidently.LaunchStatusCreated,
+			cloudwatchevidently.LaunchStatusRunning,
+			cloudwatchevidently.LaunchStatusCompleted,
+			cloudwatchevidently.LaunchStatusCancelled,
+		},
+		Refresh: statusLaunch(ctx, conn, launchName, projectNameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLaunchDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string, timeout time.Duration) (*cloudwatchevidently.Launch, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: cloudwatchevidently.LaunchStatus_Values(),
+		Target:  []string{},
+		Refresh: statusLaunch(ctx, conn, launchName, projectNameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectAvailable(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string, timeout time.Duration) (*cloudwatchevidently.Project, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.ProjectStatusUpdating},
+		Target:  []string{cloudwatchevidently.ProjectStatusAvailable},
+		Refresh: statusProject(ctx, conn, nameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string, timeout time.Duration) (*cloudwatchevidently.Project, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: cloudwatchevidently.ProjectStatus_Values(),
+		Target:  []string{},
+		Refresh: statusProject(ctx, conn, nameOrARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -12,11 +12,21 @@ func TestAccFMS_serial(t *testing.T) {
 		"Policy": {
 			"basic":                  testAccPolicy_basic,
 			"cloudfrontDistribution": testAccPolicy_cloudFrontDistribution,
+			"dnsFirewall":            testAccPolicy_dnsFirewall,
 			"includeMap":             testAccPolicy_includeMap,
 			"update":                 testAccPolicy_update,
+			"resourceSetIDs":         testAccPolicy_resourceSetIDs,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"tags":                   testAccPolicy_tags,
 		},
+		"PolicyDataSource": {
+			"basic": testAccPolicyDataSource_basic,
+		},
+		"ResourceSet": {
+			"basic":      testAccResourceSet_basic,
+			"disappears": testAccResourceSet_disappears,
+			"tags":       testAccResourceSet_tags,
+		},
 	}
 
 	for group, m := range testCases {
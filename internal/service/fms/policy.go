@@ -1,13 +1,17 @@
 package fms
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,7 +33,10 @@ func ResourcePolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -110,6 +117,14 @@ func ResourcePolicy() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"resource_set_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"resource_tags": tftags.TagsSchema(),
 			"resource_type": {
 				Type:          schema.TypeString,
@@ -137,11 +152,13 @@ func ResourcePolicy() *schema.Resource {
 						"managed_service_data": {
 							Type:             schema.TypeString,
 							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
 							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
 						},
 					},
 				},
@@ -301,14 +318,17 @@ func resourcePolicyFlattenPolicy(d *schema.ResourceData, resp *fms.GetPolicyOutp
 	}
 	d.Set("delete_unused_fm_managed_resources", resp.Policy.DeleteUnusedFMManagedResources)
 	d.Set("resource_type", resp.Policy.ResourceType)
+	if err := d.Set("resource_set_ids", aws.StringValueSlice(resp.Policy.ResourceSetIds)); err != nil {
+		return err
+	}
 	d.Set("policy_update_token", resp.Policy.PolicyUpdateToken)
 	if err := d.Set("resource_tags", flattenResourceTags(resp.Policy.ResourceTags)); err != nil {
 		return err
 	}
 
 	securityServicePolicy := []map[string]string{{
-		"type":                 *resp.Policy.SecurityServicePolicyData.Type,
-		"managed_service_data": *resp.Policy.SecurityServicePolicyData.ManagedServiceData,
+		"type":                 aws.StringValue(resp.Policy.SecurityServicePolicyData.Type),
+		"managed_service_data": aws.StringValue(resp.Policy.SecurityServicePolicyData.ManagedServiceData),
 	}}
 	if err := d.Set("security_service_policy_data", securityServicePolicy); err != nil {
 		return err
@@ -344,15 +364,70 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 
 	fmsPolicy.ResourceTags = constructResourceTags(d.Get("resource_tags"))
 
+	if v, ok := d.GetOk("resource_set_ids"); ok && v.(*schema.Set).Len() > 0 {
+		fmsPolicy.ResourceSetIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	securityServicePolicy := d.Get("security_service_policy_data").([]interface{})[0].(map[string]interface{})
 	fmsPolicy.SecurityServicePolicyData = &fms.SecurityServicePolicyData{
-		ManagedServiceData: aws.String(securityServicePolicy["managed_service_data"].(string)),
-		Type:               aws.String(securityServicePolicy["type"].(string)),
+		Type: aws.String(securityServicePolicy["type"].(string)),
+	}
+
+	if v, ok := securityServicePolicy["managed_service_data"].(string); ok && v != "" {
+		fmsPolicy.SecurityServicePolicyData.ManagedServiceData = aws.String(v)
 	}
 
 	return fmsPolicy
 }
 
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("security_service_policy_data.0.managed_service_data") {
+		return nil
+	}
+
+	policyType := diff.Get("security_service_policy_data.0.type").(string)
+	managedServiceData := diff.Get("security_service_policy_data.0.managed_service_data").(string)
+
+	if managedServiceData == "" {
+		return nil
+	}
+
+	var data map[string]interface{}
+
+	if err := json.Unmarshal([]byte(managedServiceData), &data); err != nil {
+		return fmt.Errorf("security_service_policy_data.0.managed_service_data must be a JSON object: %w", err)
+	}
+
+	// The managed service data's type must match the policy's security service type.
+	if v, ok := data["type"].(string); ok && v != policyType {
+		return fmt.Errorf("security_service_policy_data.0.managed_service_data type (%s) does not match security_service_policy_data.0.type (%s)", v, policyType)
+	}
+
+	// At least one of the keys is required for the given type.
+	var requiredKeys []string
+
+	switch policyType {
+	case fms.SecurityServiceTypeDnsFirewall:
+		requiredKeys = []string{"preProcessRuleGroups", "postProcessRuleGroups"}
+	case fms.SecurityServiceTypeNetworkAclCommon:
+		requiredKeys = []string{"networkAclEntrySet"}
+	case fms.SecurityServiceTypeThirdPartyFirewall:
+		requiredKeys = []string{"thirdPartyFirewall"}
+	}
+
+	if len(requiredKeys) == 0 {
+		return nil
+	}
+
+	for _, key := range requiredKeys {
+		if _, ok := data[key]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("security_service_policy_data.0.managed_service_data for type %s must contain one of: %s", policyType, strings.Join(requiredKeys, ", "))
+}
+
 func expandPolicyMap(set []interface{}) map[string][]*string {
 	fmsPolicyMap := map[string][]*string{}
 	if len(set) > 0 {
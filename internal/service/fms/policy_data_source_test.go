@@ -0,0 +1,52 @@
+package fms_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/fms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccPolicyDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+	dataSourceName := "data.aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckAdmin(t)
+			acctest.PreCheckOrganizationsEnabled(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, fms.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exclude_map.#", resourceName, "exclude_map.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "exclude_resource_tags", resourceName, "exclude_resource_tags"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "remediation_enabled", resourceName, "remediation_enabled"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_type_list.#", resourceName, "resource_type_list.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_service_policy_data.#", resourceName, "security_service_policy_data.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_service_policy_data.0.type", resourceName, "security_service_policy_data.0.type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPolicyConfig_basic(rName, rName), `
+data "aws_fms_policy" "test" {
+  policy_id = aws_fms_policy.test.id
+}
+`)
+}
@@ -0,0 +1,240 @@
+package fms
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceSetCreate,
+		Read:   resourceResourceSetRead,
+		Update: resourceResourceSetUpdate,
+		Delete: resourceResourceSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_set_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`), "must match a supported resource type, such as AWS::EC2::VPC, see also: https://docs.aws.amazon.com/fms/2018-01-01/APIReference/API_ResourceSet.html"),
+				},
+			},
+			"update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceResourceSetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &fms.PutResourceSetInput{
+		ResourceSet: resourceResourceSetExpandResourceSet(d),
+	}
+
+	if len(tags) > 0 {
+		input.TagList = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.PutResourceSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Resource Set (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceSet.Id))
+
+	return resourceResourceSetRead(d, meta)
+}
+
+func resourceResourceSetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindResourceSetByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FMS Resource Set %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Resource Set (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.ResourceSetArn)
+	resourceSet := output.ResourceSet
+
+	d.Set("arn", arn)
+	d.Set("description", resourceSet.Description)
+	if resourceSet.LastUpdateTime != nil {
+		d.Set("last_update_time", aws.TimeValue(resourceSet.LastUpdateTime).Format(time.RFC3339))
+	} else {
+		d.Set("last_update_time", nil)
+	}
+	d.Set("name", resourceSet.Name)
+	d.Set("resource_set_status", resourceSet.ResourceSetStatus)
+	if err := d.Set("resource_type_list", aws.StringValueSlice(resourceSet.ResourceTypeList)); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %w", err)
+	}
+	d.Set("update_token", resourceSet.UpdateToken)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for FMS Resource Set (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &fms.PutResourceSetInput{
+			ResourceSet: resourceResourceSetExpandResourceSet(d),
+		}
+
+		_, err := conn.PutResourceSet(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating FMS Resource Set (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating FMS Resource Set (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceResourceSetRead(d, meta)
+}
+
+func resourceResourceSetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	log.Printf("[DEBUG] Deleting FMS Resource Set: %s", d.Id())
+	_, err := conn.DeleteResourceSet(&fms.DeleteResourceSetInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Resource Set (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindResourceSetByID(conn *fms.FMS, id string) (*fms.GetResourceSetOutput, error) {
+	input := &fms.GetResourceSetInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetResourceSet(input)
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ResourceSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func resourceResourceSetExpandResourceSet(d *schema.ResourceData) *fms.ResourceSet {
+	apiObject := &fms.ResourceSet{
+		Name:             aws.String(d.Get("name").(string)),
+		ResourceTypeList: flex.ExpandStringSet(d.Get("resource_type_list").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if d.Id() != "" {
+		apiObject.Id = aws.String(d.Id())
+		apiObject.UpdateToken = aws.String(d.Get("update_token").(string))
+	}
+
+	return apiObject
+}
@@ -1,6 +1,7 @@
 package glacier
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -16,12 +18,16 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	vaultLockStateInProgress = "InProgress"
+	vaultLockStateLocked     = "Locked"
+)
+
 func ResourceVaultLock() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceVaultLockCreate,
 		Read:   resourceVaultLockRead,
-		// Allow ignore_deletion_error update
-		Update: schema.Noop,
+		Update: resourceVaultLockUpdate,
 		Delete: resourceVaultLockDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -31,7 +37,14 @@ func ResourceVaultLock() *schema.Resource {
 			"complete_lock": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"ignore_deletion_error": {
 				Type:     schema.TypeBool,
@@ -41,7 +54,6 @@ func ResourceVaultLock() *schema.Resource {
 			"policy": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ForceNew:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 				StateFunc: func(v interface{}) string {
@@ -49,6 +61,10 @@ func ResourceVaultLock() *schema.Resource {
 					return json
 				},
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vault_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -56,6 +72,17 @@ func ResourceVaultLock() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+
+		// Once the lock has been completed, the policy is immutable.
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("policy", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				o, _ := d.GetChange("complete_lock")
+				return d.HasChange("policy") && o.(bool)
+			}),
+			customdiff.ForceNewIfChange("complete_lock", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+		),
 	}
 }
 
@@ -69,38 +96,18 @@ func resourceVaultLockCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
 	}
 
-	input := &glacier.InitiateVaultLockInput{
-		AccountId: aws.String("-"),
-		Policy: &glacier.VaultLockPolicy{
-			Policy: aws.String(policy),
-		},
-		VaultName: aws.String(vaultName),
-	}
+	lockID, err := initiateVaultLock(conn, vaultName, policy)
 
-	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
-	output, err := conn.InitiateVaultLock(input)
 	if err != nil {
-		return fmt.Errorf("error initiating Glacier Vault Lock: %s", err)
+		return err
 	}
 
 	d.SetId(vaultName)
 
-	if !d.Get("complete_lock").(bool) {
-		return resourceVaultLockRead(d, meta)
-	}
-
-	completeLockInput := &glacier.CompleteVaultLockInput{
-		LockId:    output.LockId,
-		VaultName: aws.String(vaultName),
-	}
-
-	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, completeLockInput)
-	if _, err := conn.CompleteVaultLock(completeLockInput); err != nil {
-		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %s", vaultName, err)
-	}
-
-	if err := waitVaultLockCompletion(conn, vaultName); err != nil {
-		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %s", d.Id(), err)
+	if d.Get("complete_lock").(bool) {
+		if err := completeVaultLock(conn, vaultName, lockID); err != nil {
+			return err
+		}
 	}
 
 	return resourceVaultLockRead(d, meta)
@@ -133,7 +140,10 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	d.Set("complete_lock", aws.StringValue(output.State) == "Locked")
+	d.Set("complete_lock", aws.StringValue(output.State) == vaultLockStateLocked)
+	d.Set("creation_date", output.CreationDate)
+	d.Set("expiration_date", output.ExpirationDate)
+	d.Set("state", output.State)
 	d.Set("vault_name", d.Id())
 
 	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
@@ -147,6 +157,45 @@ func resourceVaultLockRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceVaultLockUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	// A lock that is still InProgress cannot be modified in place and its lock ID
+	// is only returned on initiation, so abort it and initiate a new one.
+	if d.HasChanges("complete_lock", "policy") {
+		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+		if err != nil {
+			return fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+		}
+
+		input := &glacier.AbortVaultLockInput{
+			VaultName: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Aborting Glacier Vault Lock (%s): %s", d.Id(), input)
+		_, err = conn.AbortVaultLock(input)
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, glacier.ErrCodeResourceNotFoundException) {
+			return fmt.Errorf("error aborting Glacier Vault Lock (%s): %w", d.Id(), err)
+		}
+
+		lockID, err := initiateVaultLock(conn, d.Id(), policy)
+
+		if err != nil {
+			return err
+		}
+
+		if d.Get("complete_lock").(bool) {
+			if err := completeVaultLock(conn, d.Id(), lockID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceVaultLockRead(d, meta)
+}
+
 func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlacierConn
 
@@ -168,6 +217,43 @@ func resourceVaultLockDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func initiateVaultLock(conn *glacier.Glacier, vaultName, policy string) (*string, error) {
+	input := &glacier.InitiateVaultLockInput{
+		AccountId: aws.String("-"),
+		Policy: &glacier.VaultLockPolicy{
+			Policy: aws.String(policy),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Initiating Glacier Vault Lock: %s", input)
+	output, err := conn.InitiateVaultLock(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error initiating Glacier Vault (%s) Lock: %w", vaultName, err)
+	}
+
+	return output.LockId, nil
+}
+
+func completeVaultLock(conn *glacier.Glacier, vaultName string, lockID *string) error {
+	input := &glacier.CompleteVaultLockInput{
+		LockId:    lockID,
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Completing Glacier Vault (%s) Lock: %s", vaultName, input)
+	if _, err := conn.CompleteVaultLock(input); err != nil {
+		return fmt.Errorf("error completing Glacier Vault (%s) Lock: %w", vaultName, err)
+	}
+
+	if err := waitVaultLockCompletion(conn, vaultName); err != nil {
+		return fmt.Errorf("error waiting for Glacier Vault Lock (%s) completion: %w", vaultName, err)
+	}
+
+	return nil
+}
+
 func vaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &glacier.GetVaultLockInput{
@@ -196,8 +282,8 @@ func vaultLockRefreshFunc(conn *glacier.Glacier, vaultName string) resource.Stat
 
 func waitVaultLockCompletion(conn *glacier.Glacier, vaultName string) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"InProgress"},
-		Target:  []string{"Locked"},
+		Pending: []string{vaultLockStateInProgress},
+		Target:  []string{vaultLockStateLocked},
 		Refresh: vaultLockRefreshFunc(conn, vaultName),
 		Timeout: 5 * time.Minute,
 	}
@@ -2,6 +2,7 @@ package glacier_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -78,6 +79,41 @@ func TestAccGlacierVaultLock_completeLock(t *testing.T) {
 	})
 }
 
+func TestAccGlacierVaultLock_updatePolicyInProgress(t *testing.T) {
+	var vaultLock1, vaultLock2 glacier.GetVaultLockOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault_lock.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, glacier.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckVaultLockDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultLockArchiveAgeConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(resourceName, &vaultLock1),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"glacier:ArchiveAgeinDays":"0"`)),
+				),
+			},
+			{
+				Config: testAccVaultLockArchiveAgeConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultLockExists(resourceName, &vaultLock2),
+					resource.TestCheckResourceAttr(resourceName, "complete_lock", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "InProgress"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"glacier:ArchiveAgeinDays":"1"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlacierVaultLock_ignoreEquivalentPolicy(t *testing.T) {
 	var vaultLock1 glacier.GetVaultLockOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -207,6 +243,43 @@ resource "aws_glacier_vault_lock" "test" {
 `, rName, completeLock, completeLock)
 }
 
+func testAccVaultLockArchiveAgeConfig(rName string, archiveAgeInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    # Allow for testing purposes
+    actions   = ["glacier:DeleteArchive"]
+    effect    = "Allow"
+    resources = [aws_glacier_vault.test.arn]
+
+    condition {
+      test     = "NumericLessThanEquals"
+      variable = "glacier:ArchiveAgeinDays"
+      values   = ["%[2]d"]
+    }
+
+    principals {
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+      type        = "AWS"
+    }
+  }
+}
+
+resource "aws_glacier_vault_lock" "test" {
+  complete_lock = false
+  policy        = data.aws_iam_policy_document.test.json
+  vault_name    = aws_glacier_vault.test.name
+}
+`, rName, archiveAgeInDays)
+}
+
 func testAccVaultLockPolicyOrderConfig(rName string, completeLock bool) string {
 	return fmt.Sprintf(`
 resource "aws_glacier_vault" "test" {
@@ -2,6 +2,7 @@ package glue_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -152,6 +153,56 @@ func TestAccGlueCatalogDatabase_targetDatabase(t *testing.T) {
 	})
 }
 
+func TestAccGlueCatalogDatabase_targetDatabaseWithRegion(t *testing.T) {
+	resourceName := "aws_glue_catalog_database.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, glue.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogDatabaseConfig_targetWithRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCatalogDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database.0.catalog_id", "aws_glue_catalog_database.test2", "catalog_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database.0.database_name", "aws_glue_catalog_database.test2", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_database.0.region", "data.aws_region.current", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogDatabase_federatedDatabaseConflicts(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, glue.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCatalogDatabaseConfig_federatedAndTarget(rName),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+			{
+				Config:      testAccCatalogDatabaseConfig_federatedAndLocation(rName),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
 func TestAccGlueCatalogDatabase_disappears(t *testing.T) {
 	resourceName := "aws_glue_catalog_database.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -263,6 +314,58 @@ resource "aws_glue_catalog_database" "test2" {
 `, rName)
 }
 
+func testAccCatalogDatabaseConfig_targetWithRegion(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  target_database {
+    catalog_id    = aws_glue_catalog_database.test2.catalog_id
+    database_name = aws_glue_catalog_database.test2.name
+    region        = data.aws_region.current.name
+  }
+}
+
+resource "aws_glue_catalog_database" "test2" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
+func testAccCatalogDatabaseConfig_federatedAndTarget(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  federated_database {
+    connection_name = "aws:redshift"
+    identifier      = "arn:aws:redshift:us-east-1:123456789012:datashare:example/example"
+  }
+
+  target_database {
+    catalog_id    = "123456789012"
+    database_name = "example"
+  }
+}
+`, rName)
+}
+
+func testAccCatalogDatabaseConfig_federatedAndLocation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name         = %[1]q
+  location_uri = "my-location"
+
+  federated_database {
+    connection_name = "aws:redshift"
+    identifier      = "arn:aws:redshift:us-east-1:123456789012:datashare:example/example"
+  }
+}
+`, rName)
+}
+
 func testAccCatalogDatabaseConfig_permission(rName, permission string) string {
 	return fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -116,6 +116,29 @@ func ResourceWorkspace() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 2,
+							MaxItems: 6,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -167,6 +190,10 @@ func resourceWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.WorkspaceRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("vpc_configuration"); ok {
+		input.VpcConfiguration = expandVPCConfiguration(v.([]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating Grafana Workspace: %s", input)
 	output, err := conn.CreateWorkspace(input)
 
@@ -224,6 +251,10 @@ func resourceWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("saml_configuration_status", workspace.Authentication.SamlConfigurationStatus)
 	d.Set("stack_set_name", workspace.StackSetName)
 
+	if err := d.Set("vpc_configuration", flattenVPCConfiguration(workspace.VpcConfiguration)); err != nil {
+		return fmt.Errorf("error setting vpc_configuration: %w", err)
+	}
+
 	tags := KeyValueTags(workspace.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -286,6 +317,14 @@ func resourceWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.StackSetName = aws.String(d.Get("stack_set_name").(string))
 		}
 
+		if d.HasChange("vpc_configuration") {
+			if v, ok := d.GetOk("vpc_configuration"); ok {
+				input.VpcConfiguration = expandVPCConfiguration(v.([]interface{}))
+			} else {
+				input.RemoveVpcConfiguration = aws.Bool(true)
+			}
+		}
+
 		_, err := conn.UpdateWorkspace(input)
 
 		if err != nil {
@@ -330,3 +369,29 @@ func resourceWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func expandVPCConfiguration(l []interface{}) *managedgrafana.VpcConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &managedgrafana.VpcConfiguration{
+		SecurityGroupIds: flex.ExpandStringSet(m["security_group_ids"].(*schema.Set)),
+		SubnetIds:        flex.ExpandStringSet(m["subnet_ids"].(*schema.Set)),
+	}
+}
+
+func flattenVPCConfiguration(config *managedgrafana.VpcConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_ids": flex.FlattenStringSet(config.SecurityGroupIds),
+		"subnet_ids":         flex.FlattenStringSet(config.SubnetIds),
+	}
+
+	return []interface{}{m}
+}
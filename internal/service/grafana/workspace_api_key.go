@@ -0,0 +1,125 @@
+package grafana
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceWorkspaceAPIKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkspaceAPIKeyCreate,
+		Read:   schema.Noop,
+		Delete: resourceWorkspaceAPIKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"key_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.Role_Values(), false),
+			},
+			"seconds_to_live": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceAPIKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	keyName := d.Get("key_name").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	id := WorkspaceAPIKeyCreateResourceID(workspaceID, keyName)
+	input := &managedgrafana.CreateWorkspaceApiKeyInput{
+		KeyName:       aws.String(keyName),
+		KeyRole:       aws.String(d.Get("key_role").(string)),
+		SecondsToLive: aws.Int64(int64(d.Get("seconds_to_live").(int))),
+		WorkspaceId:   aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Creating Grafana Workspace API Key: %s", id)
+	output, err := conn.CreateWorkspaceApiKey(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Grafana Workspace API Key (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("key", output.Key)
+
+	// The API provides no way to read an API key back, so there is nothing more to refresh.
+	return nil
+}
+
+func resourceWorkspaceAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID, keyName, err := WorkspaceAPIKeyParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace API Key: %s", d.Id())
+	_, err = conn.DeleteWorkspaceApiKey(&managedgrafana.DeleteWorkspaceApiKeyInput{
+		KeyName:     aws.String(keyName),
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Grafana Workspace API Key (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const workspaceAPIKeyResourceIDSeparator = "/"
+
+func WorkspaceAPIKeyCreateResourceID(workspaceID, keyName string) string {
+	parts := []string{workspaceID, keyName}
+	id := strings.Join(parts, workspaceAPIKeyResourceIDSeparator)
+
+	return id
+}
+
+func WorkspaceAPIKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, workspaceAPIKeyResourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKSPACE-ID%[2]sKEY-NAME", id, workspaceAPIKeyResourceIDSeparator)
+}
@@ -497,6 +497,112 @@ func TestAccImageBuilderImagePipeline_status(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImagePipeline_ImageScanning_imageScanningEnabled(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_scanningConfigurationScanningEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImagePipelineConfig_scanningConfigurationScanningEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderImagePipeline_ImageScanning_ecrConfiguration(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_scanningConfigurationECRConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.ecr_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_scanning_configuration.0.ecr_configuration.0.repository_name", "aws_ecr_repository.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.ecr_configuration.0.container_tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_scanning_configuration.0.ecr_configuration.0.container_tags.*", "key1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_scanning_configuration.0.ecr_configuration.0.container_tags.*", "key2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderImagePipeline_workflow(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_pipeline.test"
+	workflowResourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckImagePipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagePipelineConfig_workflow(rName, imagebuilder.OnWorkflowFailureAbort),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow.0.workflow_arn", workflowResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", imagebuilder.OnWorkflowFailureAbort),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.parallel_group", "group1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccImagePipelineConfig_workflow(rName, imagebuilder.OnWorkflowFailureContinue),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagePipelineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "workflow.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow.0.on_failure", imagebuilder.OnWorkflowFailureContinue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderImagePipeline_tags(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_imagebuilder_image_pipeline.test"
@@ -871,6 +977,122 @@ resource "aws_imagebuilder_image_pipeline" "test" {
 `, rName, timeoutMinutes))
 }
 
+func testAccImagePipelineConfig_scanningConfigurationScanningEnabled(rName string, imageScanningEnabled bool) string {
+	return acctest.ConfigCompose(
+		testAccImagePipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+
+  image_scanning_configuration {
+    image_scanning_enabled = %[2]t
+  }
+}
+`, rName, imageScanningEnabled))
+}
+
+func testAccImagePipelineConfig_scanningConfigurationECRConfiguration(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImagePipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ecr_repository" "test" {
+  name = %[1]q
+}
+
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+
+  image_scanning_configuration {
+    image_scanning_enabled = true
+
+    ecr_configuration {
+      container_tags  = ["key1", "key2"]
+      repository_name = aws_ecr_repository.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccImagePipelineConfig_workflow(rName string, onFailure string) string {
+	return acctest.ConfigCompose(
+		testAccImagePipelineBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%[1]s-execution"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "imagebuilder.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/EC2ImageBuilderLifecycleExecutionPolicy"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_imagebuilder_workflow" "test" {
+  data = yamlencode({
+    name          = "test-image"
+    schemaVersion = 1.0
+    parameters = [{
+      name = "waitForActionAtEnd"
+      type = "boolean"
+    }]
+    steps = [{
+      action    = "LaunchInstance"
+      name      = "LaunchTestInstance"
+      onFailure = "Abort"
+      inputs = {
+        waitFor = "ssmAgent"
+      }
+      }, {
+      action    = "TerminateInstance"
+      name      = "TerminateTestInstance"
+      onFailure = "Continue"
+      inputs = {
+        "instanceId.$" = "$.stepOutputs.LaunchTestInstance.instanceId"
+      }
+    }]
+  })
+  name    = %[1]q
+  type    = "TEST"
+  version = "1.0.0"
+}
+
+resource "aws_imagebuilder_image_pipeline" "test" {
+  execution_role                   = aws_iam_role.test.arn
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = %[1]q
+
+  workflow {
+    on_failure     = %[2]q
+    parallel_group = "group1"
+    workflow_arn   = aws_imagebuilder_workflow.test.arn
+
+    parameter {
+      name  = "waitForActionAtEnd"
+      value = "false"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, onFailure))
+}
+
 func testAccImagePipelineConfig_infrastructureConfigurationARN2(rName string) string {
 	return acctest.ConfigCompose(
 		testAccImagePipelineBaseConfig(rName),
@@ -134,6 +134,33 @@ func TestAccImageBuilderImage_enhancedImageMetadataEnabled(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImage_ImageScanning_imageScanningEnabled(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig_scanningConfigurationScanningEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "image_scanning_configuration.0.image_scanning_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderImage_ImageTests_imageTestsEnabled(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_imagebuilder_image.test"
@@ -450,6 +477,21 @@ resource "aws_imagebuilder_image" "test" {
 `, rName, enhancedImageMetadataEnabled))
 }
 
+func testAccImageConfig_scanningConfigurationScanningEnabled(rName string, imageScanningEnabled bool) string {
+	return acctest.ConfigCompose(
+		testAccImageBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+
+  image_scanning_configuration {
+    image_scanning_enabled = %[2]t
+  }
+}
+`, rName, imageScanningEnabled))
+}
+
 func testAccImageConfig_testsConfigurationTestsEnabled(rName string, imageTestsEnabled bool) string {
 	return acctest.ConfigCompose(
 		testAccImageBaseConfig(rName),
@@ -50,6 +50,20 @@ func init() {
 		Name: "aws_imagebuilder_infrastructure_configuration",
 		F:    sweepInfrastructureConfigurations,
 	})
+
+	resource.AddTestSweepers("aws_imagebuilder_lifecycle_policy", &resource.Sweeper{
+		Name: "aws_imagebuilder_lifecycle_policy",
+		F:    sweepLifecyclePolicies,
+	})
+
+	resource.AddTestSweepers("aws_imagebuilder_workflow", &resource.Sweeper{
+		Name: "aws_imagebuilder_workflow",
+		F:    sweepWorkflows,
+		Dependencies: []string{
+			"aws_imagebuilder_image",
+			"aws_imagebuilder_image_pipeline",
+		},
+	})
 }
 
 func sweepComponents(region string) error {
@@ -475,3 +489,135 @@ func sweepInfrastructureConfigurations(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepWorkflows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ImageBuilderConn
+
+	var sweeperErrs *multierror.Error
+
+	input := &imagebuilder.ListWorkflowsInput{
+		Owner: aws.String(imagebuilder.OwnershipSelf),
+	}
+
+	err = conn.ListWorkflowsPages(input, func(page *imagebuilder.ListWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, workflowVersion := range page.WorkflowVersionList {
+			if workflowVersion == nil {
+				continue
+			}
+
+			arn := aws.StringValue(workflowVersion.Arn)
+			input := &imagebuilder.ListWorkflowBuildVersionsInput{
+				WorkflowVersionArn: workflowVersion.Arn,
+			}
+
+			err := conn.ListWorkflowBuildVersionsPages(input, func(page *imagebuilder.ListWorkflowBuildVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, workflowSummary := range page.WorkflowSummaryList {
+					if workflowSummary == nil {
+						continue
+					}
+
+					arn := aws.StringValue(workflowSummary.Arn)
+
+					r := ResourceWorkflow()
+					d := r.Data(nil)
+					d.SetId(arn)
+
+					err := r.Delete(d, client)
+
+					if err != nil {
+						sweeperErr := fmt.Errorf("error deleting Image Builder Workflow (%s): %w", arn, err)
+						log.Printf("[ERROR] %s", sweeperErr)
+						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+						continue
+					}
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error listing Image Builder Workflow (%s) versions: %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Image Builder Workflow sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Workflows: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepLifecyclePolicies(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).ImageBuilderConn
+
+	var sweeperErrs *multierror.Error
+
+	input := &imagebuilder.ListLifecyclePoliciesInput{}
+
+	err = conn.ListLifecyclePoliciesPages(input, func(page *imagebuilder.ListLifecyclePoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, lifecyclePolicy := range page.LifecyclePolicySummaryList {
+			if lifecyclePolicy == nil {
+				continue
+			}
+
+			arn := aws.StringValue(lifecyclePolicy.Arn)
+
+			r := ResourceLifecyclePolicy()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Image Builder Lifecycle Policy (%s): %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Image Builder Lifecycle Policy sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Image Builder Lifecycle Policies: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -0,0 +1,268 @@
+package imagebuilder_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfimagebuilder "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+)
+
+func TestAccImageBuilderWorkflow_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "imagebuilder", regexp.MustCompile(fmt.Sprintf("workflow/test/%s/1.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttr(resourceName, "change_description", ""),
+					resource.TestMatchResourceAttr(resourceName, "data", regexp.MustCompile(`schemaVersion`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", imagebuilder.WorkflowTypeTest),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderWorkflow_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfimagebuilder.ResourceWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderWorkflow_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, imagebuilder.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkflowConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccWorkflowConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_imagebuilder_workflow" {
+			continue
+		}
+
+		input := &imagebuilder.GetWorkflowInput{
+			WorkflowBuildVersionArn: aws.String(rs.Primary.ID),
+		}
+
+		output, err := conn.GetWorkflow(input)
+
+		if tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Workflow (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("Image Builder Workflow (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn
+
+		input := &imagebuilder.GetWorkflowInput{
+			WorkflowBuildVersionArn: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.GetWorkflow(input)
+
+		if err != nil {
+			return fmt.Errorf("error getting Image Builder Workflow (%s): %w", rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccWorkflowConfig_name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  data = yamlencode({
+    name          = "test-image"
+    schemaVersion = 1.0
+    steps = [{
+      action    = "LaunchInstance"
+      name      = "LaunchTestInstance"
+      onFailure = "Abort"
+      inputs = {
+        waitFor = "ssmAgent"
+      }
+      }, {
+      action    = "TerminateInstance"
+      name      = "TerminateTestInstance"
+      onFailure = "Continue"
+      inputs = {
+        "instanceId.$" = "$.stepOutputs.LaunchTestInstance.instanceId"
+      }
+    }]
+  })
+  name    = %[1]q
+  type    = "TEST"
+  version = "1.0.0"
+}
+`, rName)
+}
+
+func testAccWorkflowConfig_tags1(rName string, tagKey1 string, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  data = yamlencode({
+    name          = "test-image"
+    schemaVersion = 1.0
+    steps = [{
+      action    = "LaunchInstance"
+      name      = "LaunchTestInstance"
+      onFailure = "Abort"
+      inputs = {
+        waitFor = "ssmAgent"
+      }
+      }, {
+      action    = "TerminateInstance"
+      name      = "TerminateTestInstance"
+      onFailure = "Continue"
+      inputs = {
+        "instanceId.$" = "$.stepOutputs.LaunchTestInstance.instanceId"
+      }
+    }]
+  })
+  name    = %[1]q
+  type    = "TEST"
+  version = "1.0.0"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkflowConfig_tags2(rName string, tagKey1 string, tagValue1 string, tagKey2 string, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_imagebuilder_workflow" "test" {
+  data = yamlencode({
+    name          = "test-image"
+    schemaVersion = 1.0
+    steps = [{
+      action    = "LaunchInstance"
+      name      = "LaunchTestInstance"
+      onFailure = "Abort"
+      inputs = {
+        waitFor = "ssmAgent"
+      }
+      }, {
+      action    = "TerminateInstance"
+      name      = "TerminateTestInstance"
+      onFailure = "Continue"
+      inputs = {
+        "instanceId.$" = "$.stepOutputs.LaunchTestInstance.instanceId"
+      }
+    }]
+  })
+  name    = %[1]q
+  type    = "TEST"
+  version = "1.0.0"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
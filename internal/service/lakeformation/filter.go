@@ -31,6 +31,14 @@ func FilterPermissions(input *lakeformation.ListPermissionsInput, tableType stri
 		return FilterDatabasePermissions(input.Principal.DataLakePrincipalIdentifier, allPermissions)
 	}
 
+	if input.Resource.LFTag != nil {
+		return FilterLFTagPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTag, allPermissions)
+	}
+
+	if input.Resource.LFTagPolicy != nil {
+		return FilterLFTagPolicyPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTagPolicy, allPermissions)
+	}
+
 	if tableType == TableTypeTableWithColumns {
 		return FilterTableWithColumnsPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.Table, columnNames, excludedColumnNames, columnWildcard, allPermissions)
 	}
@@ -176,3 +184,66 @@ func FilterDatabasePermissions(principal *string, allPermissions []*lakeformatio
 
 	return cleanPermissions
 }
+
+func FilterLFTagPermissions(principal *string, lfTag *lakeformation.LFTagKeyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTag == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.LFTag.TagKey) == aws.StringValue(lfTag.TagKey) && StringSlicesEqualIgnoreOrder(perm.Resource.LFTag.TagValues, lfTag.TagValues) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+func FilterLFTagPolicyPermissions(principal *string, lfTagPolicy *lakeformation.LFTagPolicyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTagPolicy == nil {
+			continue
+		}
+
+		if aws.StringValue(perm.Resource.LFTagPolicy.ResourceType) == aws.StringValue(lfTagPolicy.ResourceType) && lfTagExpressionsEqual(perm.Resource.LFTagPolicy.Expression, lfTagPolicy.Expression) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+// lfTagExpressionsEqual reports whether two LF-tag expressions contain the same
+// tag keys with the same tag values, ignoring order.
+func lfTagExpressionsEqual(e1, e2 []*lakeformation.LFTag) bool {
+	if len(e1) != len(e2) {
+		return false
+	}
+
+	values := make(map[string][]*string, len(e1))
+	for _, v := range e1 {
+		values[aws.StringValue(v.TagKey)] = v.TagValues
+	}
+
+	for _, v := range e2 {
+		tagValues, ok := values[aws.StringValue(v.TagKey)]
+
+		if !ok || !StringSlicesEqualIgnoreOrder(tagValues, v.TagValues) {
+			return false
+		}
+	}
+
+	return true
+}
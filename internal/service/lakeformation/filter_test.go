@@ -534,6 +534,142 @@ func TestFilterPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "lfTagResource",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTag: &lakeformation.LFTagKeyResource{
+						CatalogId: aws.String(accountID),
+						TagKey:    aws.String("environment"),
+						TagValues: aws.StringSlice([]string{"prod", "dev"}),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("team"),
+							TagValues: aws.StringSlice([]string{"prod", "dev"}),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionAssociate}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("environment"),
+							TagValues: aws.StringSlice([]string{"dev", "prod"}),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionAssociate}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("environment"),
+							TagValues: aws.StringSlice([]string{"dev", "prod"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "lfTagPolicyResource",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTagPolicy: &lakeformation.LFTagPolicyResource{
+						CatalogId:    aws.String(accountID),
+						ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						Expression: []*lakeformation.LFTag{
+							{
+								TagKey:    aws.String("environment"),
+								TagValues: aws.StringSlice([]string{"prod"}),
+							},
+						},
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId:    aws.String(accountID),
+							ResourceType: aws.String(lakeformation.ResourceTypeDatabase),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("environment"),
+									TagValues: aws.StringSlice([]string{"prod"}),
+								},
+							},
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId:    aws.String(accountID),
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("environment"),
+									TagValues: aws.StringSlice([]string{"dev"}),
+								},
+							},
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId:    aws.String(accountID),
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("environment"),
+									TagValues: aws.StringSlice([]string{"prod"}),
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					Principal:   principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId:    aws.String(accountID),
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("environment"),
+									TagValues: aws.StringSlice([]string{"prod"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -37,3 +37,18 @@ func validPrincipal(v interface{}, k string) (ws []string, errors []error) {
 
 	return ws, errors
 }
+
+func validLFTagValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if len(value) < 1 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 256 characters in length: %q", k, value))
+	}
+
+	pattern := `^[\p{L}\p{Z}\p{N}_.:\*\/=+\-@%]*$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q contains characters that are not allowed in an LF-Tag value: %q", k, value))
+	}
+
+	return ws, errors
+}
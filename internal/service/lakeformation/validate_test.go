@@ -1,6 +1,7 @@
 package lakeformation
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,3 +55,31 @@ func TestValidPrincipal(t *testing.T) {
 		}
 	}
 }
+
+func TestValidLFTagValue(t *testing.T) {
+	validValues := []string{
+		"value",
+		"Value With Spaces",
+		"*",
+		"a/b=c+d-e@f%g:h.i_j",
+	}
+	for _, v := range validValues {
+		_, errors := validLFTagValue(v, "values")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid LF-Tag value: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"value#",
+		"value,other",
+		strings.Repeat("a", 257),
+	}
+	for _, v := range invalidValues {
+		_, errors := validLFTagValue(v, "values")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid LF-Tag value", v)
+		}
+	}
+}
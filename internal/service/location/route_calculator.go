@@ -0,0 +1,192 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRouteCalculator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRouteCalculatorCreate,
+		Read:   resourceRouteCalculatorRead,
+		Update: resourceRouteCalculatorUpdate,
+		Delete: resourceRouteCalculatorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"calculator_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"calculator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceRouteCalculatorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &locationservice.CreateRouteCalculatorInput{}
+
+	if v, ok := d.GetOk("calculator_name"); ok {
+		input.CalculatorName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_source"); ok {
+		input.DataSource = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateRouteCalculator(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating route calculator: %w", err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error creating route calculator: empty result")
+	}
+
+	d.SetId(aws.StringValue(output.CalculatorName))
+
+	return resourceRouteCalculatorRead(d, meta)
+}
+
+func resourceRouteCalculatorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &locationservice.DescribeRouteCalculatorInput{
+		CalculatorName: aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeRouteCalculator(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Location Service Route Calculator (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Location Service Route Calculator (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error getting Location Service Route Calculator (%s): empty response", d.Id())
+	}
+
+	d.Set("calculator_arn", output.CalculatorArn)
+	d.Set("calculator_name", output.CalculatorName)
+	d.Set("create_time", aws.TimeValue(output.CreateTime).Format(time.RFC3339))
+	d.Set("data_source", output.DataSource)
+	d.Set("description", output.Description)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	d.Set("update_time", aws.TimeValue(output.UpdateTime).Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceRouteCalculatorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	if d.HasChange("description") {
+		input := &locationservice.UpdateRouteCalculatorInput{
+			CalculatorName: aws.String(d.Id()),
+			Description:    aws.String(d.Get("description").(string)),
+		}
+
+		_, err := conn.UpdateRouteCalculator(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Location Service Route Calculator (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("calculator_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags for Location Service Route Calculator (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceRouteCalculatorRead(d, meta)
+}
+
+func resourceRouteCalculatorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	input := &locationservice.DeleteRouteCalculatorInput{
+		CalculatorName: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteRouteCalculator(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Route Calculator (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
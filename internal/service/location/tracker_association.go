@@ -0,0 +1,180 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTrackerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrackerAssociationCreate,
+		Read:   resourceTrackerAssociationRead,
+		Delete: resourceTrackerAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tracker_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceTrackerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	consumerARN := d.Get("consumer_arn").(string)
+	trackerName := d.Get("tracker_name").(string)
+	id := TrackerAssociationCreateResourceID(trackerName, consumerARN)
+	input := &locationservice.AssociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	}
+
+	_, err := conn.AssociateTrackerConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Location Service Tracker Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTrackerAssociationRead(d, meta)
+}
+
+func resourceTrackerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = FindTrackerAssociationByTrackerNameAndConsumerARN(conn, trackerName, consumerARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Location Service Tracker Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Location Service Tracker Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("consumer_arn", consumerARN)
+	d.Set("tracker_name", trackerName)
+
+	return nil
+}
+
+func resourceTrackerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LocationConn
+
+	trackerName, consumerARN, err := TrackerAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &locationservice.DisassociateTrackerConsumerInput{
+		ConsumerArn: aws.String(consumerARN),
+		TrackerName: aws.String(trackerName),
+	}
+
+	_, err = conn.DisassociateTrackerConsumer(input)
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Location Service Tracker Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindTrackerAssociationByTrackerNameAndConsumerARN(conn *locationservice.LocationService, trackerName, consumerARN string) error {
+	input := &locationservice.ListTrackerConsumersInput{
+		TrackerName: aws.String(trackerName),
+	}
+
+	var found bool
+
+	err := conn.ListTrackerConsumersPages(input, func(page *locationservice.ListTrackerConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConsumerArns {
+			if aws.StringValue(v) == consumerARN {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, locationservice.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
+
+const trackerAssociationResourceIDSeparator = "|"
+
+func TrackerAssociationCreateResourceID(trackerName, consumerARN string) string {
+	parts := []string{trackerName, consumerARN}
+	id := strings.Join(parts, trackerAssociationResourceIDSeparator)
+
+	return id
+}
+
+func TrackerAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, trackerAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TRACKER-NAME%[2]sCONSUMER-ARN", id, trackerAssociationResourceIDSeparator)
+}
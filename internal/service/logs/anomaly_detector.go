@@ -0,0 +1,250 @@
+package logs
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"anomaly_visibility_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(7, 90),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"detector_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"evaluation_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchlogs.EvaluationFrequency_Values(), false),
+			},
+			"filter_pattern": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"log_group_arn_list": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &cloudwatchlogs.CreateLogAnomalyDetectorInput{
+		LogGroupArnList: flex.ExpandStringSet(d.Get("log_group_arn_list").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("detector_name"); ok {
+		input.DetectorName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("filter_pattern"); ok {
+		input.FilterPattern = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Logs Anomaly Detector: %s", input)
+	output, err := conn.CreateLogAnomalyDetectorWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating CloudWatch Logs Anomaly Detector: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.AnomalyDetectorArn))
+
+	// Detectors are always created enabled.
+	if !d.Get("enabled").(bool) {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return diag.Errorf("error disabling CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAnomalyDetectorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Logs Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	d.Set("anomaly_visibility_time", output.AnomalyVisibilityTime)
+	d.Set("arn", d.Id())
+	d.Set("detector_name", output.DetectorName)
+	d.Set("enabled", aws.StringValue(output.AnomalyDetectorStatus) != cloudwatchlogs.AnomalyDetectorStatusPaused)
+	d.Set("evaluation_frequency", output.EvaluationFrequency)
+	d.Set("filter_pattern", output.FilterPattern)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("log_group_arn_list", aws.StringValueSlice(output.LogGroupArnList))
+
+	tags, err := resourceListTags(conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error listing tags for CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		if err := updateAnomalyDetector(ctx, conn, d); err != nil {
+			return diag.Errorf("error updating CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := resourceUpdateTags(conn, d.Id(), o, n); err != nil {
+			return diag.Errorf("error updating CloudWatch Logs Anomaly Detector (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).LogsConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Anomaly Detector: %s", d.Id())
+	_, err := conn.DeleteLogAnomalyDetectorWithContext(ctx, &cloudwatchlogs.DeleteLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Logs Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// updateAnomalyDetector calls UpdateLogAnomalyDetector with the full set of updatable arguments,
+// as the API requires the enabled state to be specified on every call.
+func updateAnomalyDetector(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, d *schema.ResourceData) error {
+	input := &cloudwatchlogs.UpdateLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(d.Id()),
+		Enabled:            aws.Bool(d.Get("enabled").(bool)),
+	}
+
+	if v, ok := d.GetOk("anomaly_visibility_time"); ok {
+		input.AnomalyVisibilityTime = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("evaluation_frequency"); ok {
+		input.EvaluationFrequency = aws.String(v.(string))
+	}
+
+	// An empty filter pattern is sent so that removing the argument clears it.
+	if d.HasChange("filter_pattern") {
+		input.FilterPattern = aws.String(d.Get("filter_pattern").(string))
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Logs Anomaly Detector: %s", input)
+	_, err := conn.UpdateLogAnomalyDetectorWithContext(ctx, input)
+
+	return err
+}
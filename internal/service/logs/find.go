@@ -40,8 +40,26 @@ func FindSubscriptionFilter(conn *cloudwatchlogs.CloudWatchLogs, logGroupName, n
 		LogGroupName:     aws.String(logGroupName),
 		FilterNamePrefix: aws.String(name),
 	}
+	var output *cloudwatchlogs.SubscriptionFilter
+
+	// A log group can have more than one subscription filter and
+	// the filter name is only used as a prefix, so match on the exact name.
+	err := conn.DescribeSubscriptionFiltersPages(input, func(page *cloudwatchlogs.DescribeSubscriptionFiltersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SubscriptionFilters {
+			if v != nil && aws.StringValue(v.FilterName) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
 
-	output, err := conn.DescribeSubscriptionFilters(input)
 	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
 		return nil, &resource.NotFoundError{
 			LastError:   err,
@@ -57,11 +75,63 @@ func FindSubscriptionFilter(conn *cloudwatchlogs.CloudWatchLogs, logGroupName, n
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	filters := output.SubscriptionFilters
+	return output, nil
+}
+
+func FindDataProtectionPolicyByLogGroupName(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, name string) (*cloudwatchlogs.GetDataProtectionPolicyOutput, error) {
+	input := &cloudwatchlogs.GetDataProtectionPolicyInput{
+		LogGroupIdentifier: aws.String(name),
+	}
+
+	output, err := conn.GetDataProtectionPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A log group without a data protection policy returns an empty document.
+	if output == nil || aws.StringValue(output.PolicyDocument) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAnomalyDetectorByARN(ctx context.Context, conn *cloudwatchlogs.CloudWatchLogs, arn string) (*cloudwatchlogs.GetLogAnomalyDetectorOutput, error) {
+	input := &cloudwatchlogs.GetLogAnomalyDetectorInput{
+		AnomalyDetectorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLogAnomalyDetectorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchlogs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
 
-	if len(filters) == 0 || filters[0] == nil {
+	if output == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return filters[0], nil
+	if status := aws.StringValue(output.AnomalyDetectorStatus); status == cloudwatchlogs.AnomalyDetectorStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
 }
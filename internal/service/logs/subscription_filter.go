@@ -99,9 +99,10 @@ func resourceSubscriptionFilterCreate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating Cloudwatch log subscription filter: %s", err)
 	}
 
-	d.SetId(subscriptionFilterID(d.Get("log_group_name").(string)))
+	d.SetId(subscriptionFilterID(d.Get("log_group_name").(string), d.Get("name").(string)))
 	log.Printf("[DEBUG] Cloudwatch logs subscription %q created", d.Id())
-	return nil
+
+	return resourceSubscriptionFilterRead(d, meta)
 }
 
 func resourceSubscriptionFilterUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -137,7 +138,6 @@ func resourceSubscriptionFilterUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("error updating CloudWatch Log Subscription Filter (%s): %w", d.Get("log_group_name").(string), err)
 	}
 
-	d.SetId(subscriptionFilterID(d.Get("log_group_name").(string)))
 	return resourceSubscriptionFilterRead(d, meta)
 }
 
@@ -219,15 +219,17 @@ func resourceSubscriptionFilterImport(d *schema.ResourceData, meta interface{})
 
 	d.Set("log_group_name", logGroupName)
 	d.Set("name", filterNamePrefix)
-	d.SetId(subscriptionFilterID(filterNamePrefix))
+	d.SetId(subscriptionFilterID(logGroupName, filterNamePrefix))
 
 	return []*schema.ResourceData{d}, nil
 }
 
-func subscriptionFilterID(log_group_name string) string {
+func subscriptionFilterID(log_group_name, name string) string {
 	var buf bytes.Buffer
 
-	buf.WriteString(fmt.Sprintf("%s-", log_group_name)) // only one filter allowed per log_group_name at the moment
+	// A log group can have more than one subscription filter.
+	buf.WriteString(fmt.Sprintf("%s-", log_group_name))
+	buf.WriteString(fmt.Sprintf("%s-", name))
 
 	return fmt.Sprintf("cwlsf-%d", create.StringHashcode(buf.String()))
 }
@@ -69,6 +69,47 @@ func TestAccLogsSubscriptionFilter_many(t *testing.T) {
 	})
 }
 
+func TestAccLogsSubscriptionFilter_twoFiltersSameLogGroup(t *testing.T) {
+	var filter1, filter2 cloudwatchlogs.SubscriptionFilter
+
+	resource1Name := "aws_cloudwatch_log_subscription_filter.test"
+	resource2Name := "aws_cloudwatch_log_subscription_filter.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudwatchlogs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSubscriptionFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionFilterConfig_twoFiltersSameLogGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionFilterExists(resource1Name, &filter1),
+					testAccCheckSubscriptionFilterExists(resource2Name, &filter2),
+					resource.TestCheckResourceAttr(resource1Name, "name", rName),
+					resource.TestCheckResourceAttr(resource1Name, "filter_pattern", "logtype test"),
+					resource.TestCheckResourceAttr(resource2Name, "name", rName+"-2"),
+					resource.TestCheckResourceAttr(resource2Name, "filter_pattern", "logtype test2"),
+					resource.TestCheckResourceAttrPair(resource1Name, "log_group_name", resource2Name, "log_group_name"),
+				),
+			},
+			{
+				ResourceName:      resource1Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSubscriptionFilterImportStateIDFunc(resource1Name),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resource2Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSubscriptionFilterImportStateIDFunc(resource2Name),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccLogsSubscriptionFilter_disappears(t *testing.T) {
 	var filter cloudwatchlogs.SubscriptionFilter
 
@@ -701,6 +742,24 @@ resource "aws_cloudwatch_log_subscription_filter" "test" {
 `, rName)
 }
 
+func testAccSubscriptionFilterConfig_twoFiltersSameLogGroup(rName string) string {
+	return testAccSubscriptionFilterLambdaBaseConfig(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_subscription_filter" "test" {
+  destination_arn = aws_lambda_function.test.arn
+  filter_pattern  = "logtype test"
+  log_group_name  = aws_cloudwatch_log_group.test.name
+  name            = %[1]q
+}
+
+resource "aws_cloudwatch_log_subscription_filter" "test2" {
+  destination_arn = aws_lambda_function.test.arn
+  filter_pattern  = "logtype test2"
+  log_group_name  = aws_cloudwatch_log_group.test.name
+  name            = "%[1]s-2"
+}
+`, rName)
+}
+
 func testAccSubscriptionFilterConfig_roleARN1(rName string) string {
 	return testAccSubscriptionFilterKinesisStreamBaseConfig(rName) + fmt.Sprintf(`
 resource "aws_cloudwatch_log_subscription_filter" "test" {
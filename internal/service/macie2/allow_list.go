@@ -0,0 +1,295 @@
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAllowList() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAllowListCreate,
+		ReadWithoutTimeout:   resourceAllowListRead,
+		UpdateWithoutTimeout: resourceAllowListUpdate,
+		DeleteWithoutTimeout: resourceAllowListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+						},
+						"s3_words_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 255),
+									},
+									"object_key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"criteria.0.regex", "criteria.0.s3_words_list"},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAllowListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &macie2.CreateAllowListInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Criteria:    expandAllowListCriteria(d.Get("criteria").([]interface{})[0].(map[string]interface{})),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAllowListWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Macie AllowList (%s): %w", name, err))
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	return resourceAllowListRead(ctx, d, meta)
+}
+
+func resourceAllowListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	resp, err := FindAllowListByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie AllowList (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie AllowList (%s): %w", d.Id(), err))
+	}
+
+	d.Set("arn", resp.Arn)
+	d.Set("created_at", aws.TimeValue(resp.CreatedAt).Format(time.RFC3339))
+	if err = d.Set("criteria", flattenAllowListCriteria(resp.Criteria)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie AllowList (%s): %w", "criteria", d.Id(), err))
+	}
+	d.Set("description", resp.Description)
+	d.Set("name", resp.Name)
+	if err = d.Set("status", flattenAllowListStatus(resp.Status)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie AllowList (%s): %w", "status", d.Id(), err))
+	}
+	d.Set("updated_at", aws.TimeValue(resp.UpdatedAt).Format(time.RFC3339))
+
+	tags := KeyValueTags(resp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err = d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie AllowList (%s): %w", "tags", d.Id(), err))
+	}
+
+	if err = d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting `%s` for Macie AllowList (%s): %w", "tags_all", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceAllowListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	if d.HasChanges("criteria", "description", "name") {
+		input := &macie2.UpdateAllowListInput{
+			Criteria: expandAllowListCriteria(d.Get("criteria").([]interface{})[0].(map[string]interface{})),
+			Id:       aws.String(d.Id()),
+			Name:     aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAllowListWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie AllowList (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Macie AllowList (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceAllowListRead(ctx, d, meta)
+}
+
+func resourceAllowListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	input := &macie2.DeleteAllowListInput{
+		Id: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteAllowListWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Macie AllowList (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func expandAllowListCriteria(tfMap map[string]interface{}) *macie2.AllowListCriteria {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.AllowListCriteria{}
+
+	if v, ok := tfMap["regex"].(string); ok && v != "" {
+		apiObject.Regex = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_words_list"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.S3WordsList = &macie2.S3WordsList{
+			BucketName: aws.String(tfMap["bucket_name"].(string)),
+			ObjectKey:  aws.String(tfMap["object_key"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAllowListCriteria(apiObject *macie2.AllowListCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"regex": aws.StringValue(apiObject.Regex),
+	}
+
+	if v := apiObject.S3WordsList; v != nil {
+		tfMap["s3_words_list"] = []interface{}{map[string]interface{}{
+			"bucket_name": aws.StringValue(v.BucketName),
+			"object_key":  aws.StringValue(v.ObjectKey),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAllowListStatus(apiObject *macie2.AllowListStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"code":        aws.StringValue(apiObject.Code),
+		"description": aws.StringValue(apiObject.Description),
+	}
+
+	return []interface{}{tfMap}
+}
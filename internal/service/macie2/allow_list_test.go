@@ -0,0 +1,286 @@
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccAllowList_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAllowListDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, "^[0-9]{3}-EXAMPLE$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "macie2", regexp.MustCompile(`allow-list/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", "^[0-9]{3}-EXAMPLE$"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_regex(rName, "^[0-9]{4}-EXAMPLE$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", "^[0-9]{4}-EXAMPLE$"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAllowList_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAllowListDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_regex(rName, "^[0-9]{3}-EXAMPLE$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmacie2.ResourceAllowList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAllowList_s3WordsList(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAllowListDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_s3WordsList(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.regex", ""),
+					resource.TestCheckResourceAttr(resourceName, "criteria.0.s3_words_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.bucket_name", "aws_s3_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "criteria.0.s3_words_list.0.object_key", "aws_s3_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "description", "words list"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAllowList_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_allow_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAllowListDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAllowListConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAllowListConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAllowListConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAllowListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAllowListExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie AllowList ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+		_, err := tfmacie2.FindAllowListByID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAllowListDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_allow_list" {
+			continue
+		}
+
+		_, err := tfmacie2.FindAllowListByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("macie AllowList %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAllowListConfig_regex(rName, regex string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = %[2]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, regex)
+}
+
+func testAccAllowListConfig_s3WordsList(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "allow-list.txt"
+  content = "example\n"
+}
+
+resource "aws_macie2_allow_list" "test" {
+  name        = %[1]q
+  description = "words list"
+
+  criteria {
+    s3_words_list {
+      bucket_name = aws_s3_object.test.bucket
+      object_key  = aws_s3_object.test.key
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
+
+func testAccAllowListConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "^EXAMPLE$"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAllowListConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  criteria {
+    regex = "^EXAMPLE$"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
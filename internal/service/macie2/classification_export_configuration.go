@@ -0,0 +1,180 @@
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceClassificationExportConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClassificationExportConfigurationPut,
+		ReadWithoutTimeout:   resourceClassificationExportConfigurationRead,
+		UpdateWithoutTimeout: resourceClassificationExportConfigurationPut,
+		DeleteWithoutTimeout: resourceClassificationExportConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"s3_destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"kms_key_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClassificationExportConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	input := &macie2.PutClassificationExportConfigurationInput{
+		Configuration: &macie2.ClassificationExportConfiguration{},
+	}
+
+	if v, ok := d.GetOk("s3_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration.S3Destination = expandS3Destination(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err := conn.PutClassificationExportConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error putting Macie Classification Export Configuration: %w", err))
+	}
+
+	if d.IsNewResource() {
+		d.SetId(ClassificationExportConfigurationCreateResourceID(meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region))
+	}
+
+	return resourceClassificationExportConfigurationRead(ctx, d, meta)
+}
+
+func resourceClassificationExportConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	if _, _, err := ClassificationExportConfigurationParseResourceID(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindClassificationExportConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie Classification Export Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie Classification Export Configuration (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("s3_destination", flattenS3Destination(output.S3Destination)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting s3_destination: %w", err))
+	}
+
+	return nil
+}
+
+func resourceClassificationExportConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn
+
+	// There is no delete operation; putting an empty configuration clears the export destination.
+	log.Printf("[DEBUG] Deleting Macie Classification Export Configuration: %s", d.Id())
+	_, err := conn.PutClassificationExportConfigurationWithContext(ctx, &macie2.PutClassificationExportConfigurationInput{
+		Configuration: &macie2.ClassificationExportConfiguration{},
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Macie Classification Export Configuration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+const classificationExportConfigurationResourceIDSeparator = ":"
+
+func ClassificationExportConfigurationCreateResourceID(accountID, region string) string {
+	parts := []string{accountID, region}
+	id := strings.Join(parts, classificationExportConfigurationResourceIDSeparator)
+
+	return id
+}
+
+func ClassificationExportConfigurationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, classificationExportConfigurationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ACCOUNT_ID%[2]sREGION", id, classificationExportConfigurationResourceIDSeparator)
+}
+
+func expandS3Destination(tfMap map[string]interface{}) *macie2.S3Destination {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &macie2.S3Destination{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix"].(string); ok && v != "" {
+		apiObject.KeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+		apiObject.KmsKeyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3Destination(apiObject *macie2.S3Destination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bucket_name": aws.StringValue(apiObject.BucketName),
+		"key_prefix":  aws.StringValue(apiObject.KeyPrefix),
+		"kms_key_arn": aws.StringValue(apiObject.KmsKeyArn),
+	}
+
+	return []interface{}{tfMap}
+}
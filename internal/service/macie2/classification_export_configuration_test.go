@@ -0,0 +1,203 @@
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmacie2 "github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccClassificationExportConfiguration_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_classification_export_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckClassificationExportConfigurationDestroy,
+		ErrorCheck:        acctest.ErrorCheck(t, macie2.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationExportConfigurationConfig_basic(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationExportConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_destination.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.0.key_prefix", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_destination.0.kms_key_arn", "aws_kms_key.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationExportConfigurationConfig_basic(rName, "exampleprefix/path/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationExportConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.0.key_prefix", "exampleprefix/path/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClassificationExportConfigurationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Macie Classification Export Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+		_, err := tfmacie2.FindClassificationExportConfiguration(context.Background(), conn)
+
+		return err
+	}
+}
+
+func testAccCheckClassificationExportConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_macie2_classification_export_configuration" {
+			continue
+		}
+
+		_, err := tfmacie2.FindClassificationExportConfiguration(context.Background(), conn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("macie Classification Export Configuration %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccClassificationExportConfigurationConfig_basic(rName, keyPrefix string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "AllowMacieWrite"
+        Effect = "Allow"
+        Principal = {
+          Service = "macie.amazonaws.com"
+        }
+        Action   = "s3:PutObject"
+        Resource = "${aws_s3_bucket.test.arn}/*"
+        Condition = {
+          StringEquals = {
+            "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+          }
+        }
+      },
+      {
+        Sid    = "AllowMacieGetBucketLocation"
+        Effect = "Allow"
+        Principal = {
+          Service = "macie.amazonaws.com"
+        }
+        Action   = "s3:GetBucketLocation"
+        Resource = aws_s3_bucket.test.arn
+        Condition = {
+          StringEquals = {
+            "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+          }
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid    = "Enable IAM User Permissions"
+        Effect = "Allow"
+        Principal = {
+          AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+        }
+        Action   = "kms:*"
+        Resource = "*"
+      },
+      {
+        Sid    = "AllowMacieUseOfTheKey"
+        Effect = "Allow"
+        Principal = {
+          Service = "macie.amazonaws.com"
+        }
+        Action   = ["kms:GenerateDataKey", "kms:Encrypt"]
+        Resource = "*"
+        Condition = {
+          StringEquals = {
+            "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+          }
+          ArnLike = {
+            "aws:SourceArn" = [
+              "arn:${data.aws_partition.current.partition}:macie2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:export-configuration:*",
+              "arn:${data.aws_partition.current.partition}:macie2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:classification-job/*",
+            ]
+          }
+        }
+      },
+    ]
+  })
+}
+
+resource "aws_macie2_classification_export_configuration" "test" {
+  s3_destination {
+    bucket_name = aws_s3_bucket.test.bucket
+    key_prefix  = %[2]q
+    kms_key_arn = aws_kms_key.test.arn
+  }
+
+  depends_on = [
+    aws_macie2_account.test,
+    aws_s3_bucket_policy.test,
+  ]
+}
+`, rName, keyPrefix)
+}
@@ -1,8 +1,13 @@
 package macie2
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // findMemberNotAssociated Return a list of members not associated and compare with account ID
@@ -33,3 +38,53 @@ func findMemberNotAssociated(conn *macie2.Macie2, accountID string) (*macie2.Mem
 
 	return result, err
 }
+
+func FindClassificationExportConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.ClassificationExportConfiguration, error) {
+	input := &macie2.GetClassificationExportConfigurationInput{}
+
+	output, err := conn.GetClassificationExportConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.Configuration.S3Destination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Configuration, nil
+}
+
+func FindAllowListByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetAllowListOutput, error) {
+	input := &macie2.GetAllowListInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetAllowListWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
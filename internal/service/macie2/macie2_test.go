@@ -22,6 +22,15 @@ func TestAccMacie2_serial(t *testing.T) {
 			"complete":       testAccClassificationJob_complete,
 			"tags":           testAccClassificationJob_WithTags,
 		},
+		"AllowList": {
+			"basic":         testAccAllowList_basic,
+			"disappears":    testAccAllowList_disappears,
+			"s3_words_list": testAccAllowList_s3WordsList,
+			"tags":          testAccAllowList_tags,
+		},
+		"ClassificationExportConfiguration": {
+			"basic": testAccClassificationExportConfiguration_basic,
+		},
 		"CustomDataIdentifier": {
 			"basic":              testAccCustomDataIdentifier_basic,
 			"name_generated":     testAccCustomDataIdentifier_Name_Generated,
@@ -153,7 +153,6 @@ func ResourceBroker() *schema.Resource {
 			"ldap_server_metadata": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -330,6 +329,10 @@ func ResourceBroker() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			customdiff.ForceNewIfChange("ldap_server_metadata", func(_ context.Context, old, new, meta interface{}) bool {
+				// UpdateBroker cannot remove LDAP server metadata from a broker.
+				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
+			}),
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -529,6 +532,26 @@ func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		requiresReboot = true
 	}
 
+	if d.HasChanges("authentication_strategy", "ldap_server_metadata") {
+		input := &mq.UpdateBrokerRequest{
+			BrokerId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authentication_strategy") {
+			input.AuthenticationStrategy = aws.String(d.Get("authentication_strategy").(string))
+		}
+
+		if v, ok := d.GetOk("ldap_server_metadata"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.LdapServerMetadata = expandLDAPServerMetadata(v.([]interface{}))
+		}
+
+		_, err := conn.UpdateBroker(input)
+		if err != nil {
+			return fmt.Errorf("error updating MQ Broker (%s) authentication: %w", d.Id(), err)
+		}
+		requiresReboot = true
+	}
+
 	if d.HasChange("user") {
 		o, n := d.GetChange("user")
 		var err error
@@ -1244,7 +1244,7 @@ func TestAccMQBroker_ldap(t *testing.T) {
 		t.Skip("skipping long-running test in short mode")
 	}
 
-	var broker mq.DescribeBrokerResponse
+	var broker1, broker2, broker3 mq.DescribeBrokerResponse
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_mq_broker.test"
 
@@ -1261,7 +1261,7 @@ func TestAccMQBroker_ldap(t *testing.T) {
 			{
 				Config: testAccBrokerConfig_ldap(rName, testAccBrokerVersionNewer, "anyusername"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckBrokerExists(resourceName, &broker),
+					testAccCheckBrokerExists(resourceName, &broker1),
 					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
 					resource.TestCheckResourceAttr(resourceName, "broker_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "ldap"),
@@ -1279,6 +1279,24 @@ func TestAccMQBroker_ldap(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.user_search_subtree", "true"),
 				),
 			},
+			{
+				Config: testAccBrokerConfig_ldap(rName, testAccBrokerVersionNewer, "anotherusername"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(resourceName, &broker2),
+					testAccCheckBrokerNotRecreated(&broker1, &broker2),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "ldap"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_username", "anotherusername"),
+				),
+			},
+			{
+				Config: testAccBrokerConfig_ldapRemoved(rName, testAccBrokerVersionNewer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(resourceName, &broker3),
+					testAccCheckBrokerRecreated(&broker2, &broker3),
+					resource.TestCheckResourceAttr(resourceName, "authentication_strategy", "simple"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -1361,6 +1379,16 @@ func testAccCheckBrokerNotRecreated(before, after *mq.DescribeBrokerResponse) re
 	}
 }
 
+func testAccCheckBrokerRecreated(before, after *mq.DescribeBrokerResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.BrokerId), aws.StringValue(after.BrokerId); before == after {
+			return fmt.Errorf("MQ Broker (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccBrokerConfig_basic(rName, version string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -2019,6 +2047,33 @@ resource "aws_mq_broker" "test" {
 `, rName, version, ldapUsername)
 }
 
+func testAccBrokerConfig_ldapRemoved(rName, version string) string {
+	return fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mq_broker" "test" {
+  apply_immediately       = true
+  authentication_strategy = "simple"
+  broker_name             = %[1]q
+  engine_type             = "ActiveMQ"
+  engine_version          = %[2]q
+  host_instance_type      = "mq.t2.micro"
+  security_groups         = [aws_security_group.test.id]
+
+  logs {
+    general = true
+  }
+
+  user {
+    username = "Test"
+    password = "TestTest1234"
+  }
+}
+`, rName, version)
+}
+
 func testAccBrokerConfig_instanceType(rName, version, instanceType string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
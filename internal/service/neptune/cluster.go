@@ -1,6 +1,7 @@
 package neptune
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/neptune"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -246,6 +248,27 @@ func ResourceCluster() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"serverless_v2_scaling_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validServerlessCapacity,
+						},
+						"min_capacity": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validServerlessCapacity,
+						},
+					},
+				},
+			},
+
 			"skip_final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -273,7 +296,10 @@ func ResourceCluster() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceClusterServerlessV2ScalingConfigurationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -373,6 +399,11 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 		createDbClusterInput.ReplicationSourceIdentifier = aws.String(attr.(string))
 	}
 
+	if v, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		createDbClusterInput.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		restoreDBClusterFromSnapshotInput.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if attr := d.Get("vpc_security_group_ids").(*schema.Set); attr.Len() > 0 {
 		createDbClusterInput.VpcSecurityGroupIds = flex.ExpandStringSet(attr)
 		if restoreDBClusterFromSnapshot {
@@ -504,6 +535,15 @@ func flattenClusterResource(d *schema.ResourceData, meta interface{}, dbc *neptu
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
 	d.Set("reader_endpoint", dbc.ReaderEndpoint)
 	d.Set("replication_source_identifier", dbc.ReplicationSourceIdentifier)
+
+	if dbc.ServerlessV2ScalingConfiguration != nil {
+		if err := d.Set("serverless_v2_scaling_configuration", []interface{}{flattenServerlessV2ScalingConfigurationInfo(dbc.ServerlessV2ScalingConfiguration)}); err != nil {
+			return fmt.Errorf("error setting serverless_v2_scaling_configuration: %w", err)
+		}
+	} else {
+		d.Set("serverless_v2_scaling_configuration", nil)
+	}
+
 	d.Set("storage_encrypted", dbc.StorageEncrypted)
 	d.Set("deletion_protection", dbc.DeletionProtection)
 
@@ -634,6 +674,13 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChange("serverless_v2_scaling_configuration") {
+		if v, ok := d.GetOk("serverless_v2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			req.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			requestUpdate = true
+		}
+	}
+
 	if requestUpdate {
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
@@ -754,6 +801,27 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceClusterServerlessV2ScalingConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("serverless_v2_scaling_configuration") {
+		return nil
+	}
+
+	v, ok := diff.GetOk("serverless_v2_scaling_configuration")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	minCapacity, maxCapacity := tfMap["min_capacity"].(float64), tfMap["max_capacity"].(float64)
+
+	if minCapacity > maxCapacity {
+		return fmt.Errorf("serverless_v2_scaling_configuration.0.min_capacity (%g) must be less than or equal to max_capacity (%g)", minCapacity, maxCapacity)
+	}
+
+	return nil
+}
+
 func setIAMRoleToCluster(clusterIdentifier string, roleArn string, conn *neptune.Neptune) error {
 	params := &neptune.AddRoleToDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterIdentifier),
@@ -5,3 +5,8 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	serverlessMinNCUs = 1.0
+	serverlessMaxNCUs = 128.0
+)
@@ -2,6 +2,7 @@ package neptune
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -157,3 +158,18 @@ func validSubnetGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	}
 	return
 }
+
+// validServerlessCapacity validates a Neptune Serverless capacity value,
+// which must be between 1 and 128 NCUs in increments of 0.5.
+func validServerlessCapacity(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(float64)
+	if value < serverlessMinNCUs || value > serverlessMaxNCUs {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between %.1f and %.1f NCUs, got %v", k, serverlessMinNCUs, serverlessMaxNCUs, value))
+	}
+	if math.Mod(value, 0.5) != 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be specified in increments of 0.5 NCUs, got %v", k, value))
+	}
+	return
+}
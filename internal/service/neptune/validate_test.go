@@ -209,3 +209,41 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidServerlessCapacity(t *testing.T) {
+	cases := []struct {
+		Value    float64
+		ErrCount int
+	}{
+		{
+			Value:    1,
+			ErrCount: 0,
+		},
+		{
+			Value:    2.5,
+			ErrCount: 0,
+		},
+		{
+			Value:    128,
+			ErrCount: 0,
+		},
+		{
+			Value:    0.5,
+			ErrCount: 1,
+		},
+		{
+			Value:    128.5,
+			ErrCount: 1,
+		},
+		{
+			Value:    4.25,
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validServerlessCapacity(tc.Value, "max_capacity")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for Neptune Serverless capacity %v, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -427,6 +427,12 @@ func ResourceDomain() *schema.Resource {
 				Optional: true,
 				Default:  "OpenSearch_1.1",
 			},
+			"ip_address_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(opensearchservice.IPAddressType_Values(), false),
+			},
 			"kibana_endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -468,6 +474,53 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"off_peak_window_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"off_peak_window": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"window_start_time": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"hours": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 23),
+												},
+												"minutes": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(0, 59),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"snapshot_options": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -482,6 +535,21 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			"software_update_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_software_update_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_options": {
@@ -649,6 +717,18 @@ func resourceDomainCreate(d *schema.ResourceData, meta interface{}) error {
 		inputCreateDomain.CognitoOptions = expandCognitoOptions(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("ip_address_type"); ok {
+		inputCreateDomain.IPAddressType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("off_peak_window_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		inputCreateDomain.OffPeakWindowOptions = expandOffPeakWindowOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		inputCreateDomain.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Creating OpenSearch domain: %s", inputCreateDomain)
 
 	// IAM Roles can take some time to propagate if set in AccessPolicies and created in the same terraform
@@ -818,6 +898,28 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v := dc.IPAddressType; v != nil {
+		d.Set("ip_address_type", v.Options)
+	} else {
+		d.Set("ip_address_type", ds.IPAddressType)
+	}
+
+	if v := dc.OffPeakWindowOptions; v != nil && v.Options != nil {
+		if err := d.Set("off_peak_window_options", []interface{}{flattenOffPeakWindowOptions(v.Options)}); err != nil {
+			return fmt.Errorf("error setting off_peak_window_options: %w", err)
+		}
+	} else {
+		d.Set("off_peak_window_options", nil)
+	}
+
+	if v := dc.SoftwareUpdateOptions; v != nil && v.Options != nil {
+		if err := d.Set("software_update_options", []interface{}{flattenSoftwareUpdateOptions(v.Options)}); err != nil {
+			return fmt.Errorf("error setting software_update_options: %w", err)
+		}
+	} else {
+		d.Set("software_update_options", nil)
+	}
+
 	if err := d.Set("snapshot_options", flattenSnapshotOptions(ds.SnapshotOptions)); err != nil {
 		return fmt.Errorf("error setting snapshot_options: %w", err)
 	}
@@ -994,6 +1096,22 @@ func resourceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.LogPublishingOptions = expandLogPublishingOptions(d.Get("log_publishing_options").(*schema.Set))
 		}
 
+		if d.HasChange("ip_address_type") {
+			input.IPAddressType = aws.String(d.Get("ip_address_type").(string))
+		}
+
+		if d.HasChange("off_peak_window_options") {
+			if v, ok := d.GetOk("off_peak_window_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.OffPeakWindowOptions = expandOffPeakWindowOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("software_update_options") {
+			if v, ok := d.GetOk("software_update_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SoftwareUpdateOptions = expandSoftwareUpdateOptions(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		_, err := conn.UpdateDomainConfig(&input)
 		if err != nil {
 			return err
@@ -322,3 +322,131 @@ func flattenLogPublishingOptions(o map[string]*opensearchservice.LogPublishingOp
 	}
 	return m
 }
+
+func expandOffPeakWindowOptions(tfMap map[string]interface{}) *opensearchservice.OffPeakWindowOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.OffPeakWindowOptions{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["off_peak_window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OffPeakWindow = expandOffPeakWindow(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandOffPeakWindow(tfMap map[string]interface{}) *opensearchservice.OffPeakWindow {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.OffPeakWindow{}
+
+	if v, ok := tfMap["window_start_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WindowStartTime = expandWindowStartTime(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandWindowStartTime(tfMap map[string]interface{}) *opensearchservice.WindowStartTime {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.WindowStartTime{}
+
+	if v, ok := tfMap["hours"].(int); ok {
+		apiObject.Hours = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minutes"].(int); ok {
+		apiObject.Minutes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandSoftwareUpdateOptions(tfMap map[string]interface{}) *opensearchservice.SoftwareUpdateOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &opensearchservice.SoftwareUpdateOptions{}
+
+	if v, ok := tfMap["auto_software_update_enabled"].(bool); ok {
+		apiObject.AutoSoftwareUpdateEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenOffPeakWindowOptions(apiObject *opensearchservice.OffPeakWindowOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.OffPeakWindow; v != nil {
+		tfMap["off_peak_window"] = []interface{}{flattenOffPeakWindow(v)}
+	}
+
+	return tfMap
+}
+
+func flattenOffPeakWindow(apiObject *opensearchservice.OffPeakWindow) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.WindowStartTime; v != nil {
+		tfMap["window_start_time"] = []interface{}{flattenWindowStartTime(v)}
+	}
+
+	return tfMap
+}
+
+func flattenWindowStartTime(apiObject *opensearchservice.WindowStartTime) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Hours; v != nil {
+		tfMap["hours"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Minutes; v != nil {
+		tfMap["minutes"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenSoftwareUpdateOptions(apiObject *opensearchservice.SoftwareUpdateOptions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoSoftwareUpdateEnabled; v != nil {
+		tfMap["auto_software_update_enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -1,6 +1,9 @@
 package opensearch
 
 import (
+	"context"
+	"errors"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -31,3 +34,122 @@ func FindDomainByName(conn *opensearchservice.OpenSearchService, name string) (*
 
 	return output.DomainStatus, nil
 }
+
+func FindVPCEndpointByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.VpcEndpoint, error) {
+	input := &opensearchservice.DescribeVpcEndpointsInput{
+		VpcEndpointIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeVpcEndpointsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.VpcEndpointErrors {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.ErrorCode) == opensearchservice.VpcEndpointErrorCodeEndpointNotFound {
+			return nil, &resource.NotFoundError{
+				LastError:   errors.New(aws.StringValue(v.ErrorMessage)),
+				LastRequest: input,
+			}
+		}
+
+		return nil, errors.New(aws.StringValue(v.ErrorMessage))
+	}
+
+	if len(output.VpcEndpoints) == 0 || output.VpcEndpoints[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.VpcEndpoints); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.VpcEndpoints[0], nil
+}
+
+func FindPackageByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) (*opensearchservice.PackageDetails, error) {
+	input := &opensearchservice.DescribePackagesInput{
+		Filters: []*opensearchservice.DescribePackagesFilter{
+			{
+				Name:  aws.String(opensearchservice.DescribePackagesFilterNamePackageId),
+				Value: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	output, err := conn.DescribePackagesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.PackageDetailsList) == 0 || output.PackageDetailsList[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.PackageDetailsList); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.PackageDetailsList[0], nil
+}
+
+func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
+	input := &opensearchservice.ListDomainsForPackageInput{
+		PackageID: aws.String(packageID),
+	}
+	var result *opensearchservice.DomainPackageDetails
+
+	err := conn.ListDomainsForPackagePagesWithContext(ctx, input, func(page *opensearchservice.ListDomainsForPackageOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.DomainName) == domainName {
+				result = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,154 @@
+package opensearch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePackageAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePackageAssociationCreate,
+		ReadContext:   resourcePackageAssociationRead,
+		DeleteContext: resourcePackageAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reference_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName, packageID := d.Get("domain_name").(string), d.Get("package_id").(string)
+	id := PackageAssociationCreateResourceID(domainName, packageID)
+	input := &opensearchservice.AssociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch Package Association: %s", input)
+	_, err := conn.AssociatePackageWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating OpenSearch Package Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for OpenSearch Package Association (%s) create: %s", d.Id(), err)
+	}
+
+	return resourcePackageAssociationRead(ctx, d, meta)
+}
+
+func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName, packageID, err := PackageAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	association, err := FindPackageAssociationByTwoPartKey(ctx, conn, domainName, packageID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Package Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_name", association.DomainName)
+	d.Set("package_id", association.PackageID)
+	d.Set("reference_path", association.ReferencePath)
+
+	return nil
+}
+
+func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	domainName, packageID, err := PackageAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting OpenSearch Package Association: %s", d.Id())
+	_, err = conn.DissociatePackageWithContext(ctx, &opensearchservice.DissociatePackageInput{
+		DomainName: aws.String(domainName),
+		PackageID:  aws.String(packageID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPackageAssociationDeleted(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for OpenSearch Package Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+const packageAssociationResourceIDSeparator = ","
+
+func PackageAssociationCreateResourceID(domainName, packageID string) string {
+	parts := []string{domainName, packageID}
+	id := strings.Join(parts, packageAssociationResourceIDSeparator)
+
+	return id
+}
+
+func PackageAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, packageAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-NAME%[2]sPACKAGE-ID", id, packageAssociationResourceIDSeparator)
+}
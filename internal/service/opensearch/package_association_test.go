@@ -0,0 +1,172 @@
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v opensearchservice.DomainPackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pkgName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIAMServiceLinkedRole(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPackageAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, pkgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_opensearch_domain.test", "domain_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", "aws_opensearch_package.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_path"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_disappears(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v opensearchservice.DomainPackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pkgName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckIAMServiceLinkedRole(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPackageAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig_basic(rName, pkgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourcePackageAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageAssociationExists(n string, v *opensearchservice.DomainPackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package Association ID is set")
+		}
+
+		domainName, packageID, err := tfopensearch.PackageAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindPackageAssociationByTwoPartKey(context.Background(), conn, domainName, packageID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_package_association" {
+			continue
+		}
+
+		domainName, packageID, err := tfopensearch.PackageAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfopensearch.FindPackageAssociationByTwoPartKey(context.Background(), conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Package Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageAssociationConfig_basic(rName, pkgName string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_base(rName), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name = %[2]q
+  package_type = "TXT-DICTIONARY"
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+}
+
+resource "aws_opensearch_domain" "test" {
+  domain_name    = substr(%[1]q, 0, 28)
+  engine_version = "OpenSearch_1.1"
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+}
+
+resource "aws_opensearch_package_association" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  package_id  = aws_opensearch_package.test.id
+}
+`, rName, pkgName))
+}
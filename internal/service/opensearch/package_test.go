@@ -0,0 +1,158 @@
+package opensearch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchPackage_basic(t *testing.T) {
+	var v opensearchservice.PackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pkgName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, pkgName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "available_package_version"),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "test description"),
+					resource.TestCheckResourceAttrPair(resourceName, "package_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "package_name", pkgName),
+					resource.TestCheckResourceAttr(resourceName, "package_type", opensearchservice.PackageTypeTxtDictionary),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"package_source"},
+			},
+			{
+				Config: testAccPackageConfig_basic(rName, pkgName, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "package_description", "updated description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackage_disappears(t *testing.T) {
+	var v opensearchservice.PackageDetails
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	pkgName := sdkacctest.RandomWithPrefix("tf-acc")
+	resourceName := "aws_opensearch_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, opensearchservice.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageConfig_basic(rName, pkgName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourcePackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageExists(n string, v *opensearchservice.PackageDetails) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+		output, err := tfopensearch.FindPackageByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPackageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_package" {
+			continue
+		}
+
+		_, err := tfopensearch.FindPackageByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Package %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "synonyms.txt"
+  content = "danish, croissant, pastry"
+}
+`, rName)
+}
+
+func testAccPackageConfig_basic(rName, pkgName, description string) string {
+	return acctest.ConfigCompose(testAccPackageConfig_base(rName), fmt.Sprintf(`
+resource "aws_opensearch_package" "test" {
+  package_name        = %[1]q
+  package_description = %[2]q
+  package_type        = "TXT-DICTIONARY"
+
+  package_source {
+    s3_bucket_name = aws_s3_bucket.test.bucket
+    s3_key         = aws_s3_object.test.key
+  }
+}
+`, pkgName, description))
+}
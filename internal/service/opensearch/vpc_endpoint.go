@@ -0,0 +1,177 @@
+package opensearch
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVPCEndpointCreate,
+		ReadContext:   resourceVPCEndpointRead,
+		UpdateContext: resourceVPCEndpointUpdate,
+		DeleteContext: resourceVPCEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_options": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zones": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	input := &opensearchservice.CreateVpcEndpointInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		DomainArn:   aws.String(d.Get("domain_arn").(string)),
+		VpcOptions:  expandVPCOptions(d.Get("vpc_options").([]interface{})[0].(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating OpenSearch VPC Endpoint: %s", input)
+	output, err := conn.CreateVpcEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating OpenSearch VPC Endpoint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VpcEndpoint.VpcEndpointId))
+
+	if _, err := waitVPCEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for OpenSearch VPC Endpoint (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(ctx, d, meta)
+}
+
+func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	endpoint, err := FindVPCEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("domain_arn", endpoint.DomainArn)
+	d.Set("endpoint", endpoint.Endpoint)
+
+	if endpoint.VpcOptions != nil {
+		if err := d.Set("vpc_options", flattenVPCDerivedInfo(endpoint.VpcOptions)); err != nil {
+			return diag.Errorf("setting vpc_options: %s", err)
+		}
+	} else {
+		d.Set("vpc_options", nil)
+	}
+
+	return nil
+}
+
+func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	input := &opensearchservice.UpdateVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+		VpcOptions:    expandVPCOptions(d.Get("vpc_options").([]interface{})[0].(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating OpenSearch VPC Endpoint: %s", input)
+	_, err := conn.UpdateVpcEndpointWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("waiting for OpenSearch VPC Endpoint (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(ctx, d, meta)
+}
+
+func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchConn
+
+	log.Printf("[DEBUG] Deleting OpenSearch VPC Endpoint: %s", d.Id())
+	_, err := conn.DeleteVpcEndpointWithContext(ctx, &opensearchservice.DeleteVpcEndpointInput{
+		VpcEndpointId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting OpenSearch VPC Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for OpenSearch VPC Endpoint (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package opensearch
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -153,3 +155,138 @@ func waitForDomainDelete(conn *opensearchservice.OpenSearchService, domainName s
 
 	return nil
 }
+
+func waitVPCEndpointCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusCreating},
+		Target:  []string{opensearchservice.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointUpdated(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusUpdating},
+		Target:  []string{opensearchservice.VpcEndpointStatusActive},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitVPCEndpointDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.VpcEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.VpcEndpointStatusDeleting},
+		Target:  []string{},
+		Refresh: statusVPCEndpoint(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.VpcEndpoint); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAvailable(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusCopying, opensearchservice.PackageStatusValidating},
+		Target:  []string{opensearchservice.PackageStatusAvailable},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusDeleting},
+		Target:  []string{},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationCreated(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusAssociating},
+		Target:  []string{opensearchservice.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociationDeleted(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string, timeout time.Duration) (*opensearchservice.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearchservice.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(ctx, conn, domainName, packageID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.DomainPackageDetails); ok {
+		if v := output.ErrorDetails; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -262,10 +262,20 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 		})
 	}
 
-	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException, organizations.ErrCodeAccountAlreadyClosedException) {
 		return nil
 	}
 
+	var cve *organizations.ConstraintViolationException
+	if errors.As(err, &cve) {
+		switch aws.StringValue(cve.Reason) {
+		case organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded:
+			return fmt.Errorf("error closing AWS Organizations Account (%s): the organization has reached its quota of member account closures for the current period, retry later or set close_on_deletion to false to remove the account from the organization instead: %w", d.Id(), err)
+		case organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded:
+			return fmt.Errorf("error closing AWS Organizations Account (%s): too many member account closures are in progress, retry once pending closures complete: %w", d.Id(), err)
+		}
+	}
+
 	if err != nil {
 		return fmt.Errorf("error deleting AWS Organizations Account (%s): %w", d.Id(), err)
 	}
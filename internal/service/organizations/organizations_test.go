@@ -66,6 +66,11 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"basic":      testAccDelegatedAdministrator_basic,
 			"disappears": testAccDelegatedAdministrator_disappears,
 		},
+		"ResourcePolicy": {
+			"basic":      testAccResourcePolicy_basic,
+			"disappears": testAccResourcePolicy_disappears,
+			"Tags":       testAccResourcePolicy_tags,
+		},
 		"ResourceTags": {
 			"basic": testAccResourceTagsDataSource_basic,
 		},
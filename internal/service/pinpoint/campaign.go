@@ -0,0 +1,1029 @@
+package pinpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCampaign() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCampaignCreate,
+		Read:   resourceCampaignRead,
+		Update: resourceCampaignUpdate,
+		Delete: resourceCampaignDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"holdout_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"hook": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Mode_Values(), false),
+						},
+						"web_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"is_paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"maximum_duration": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+						"messages_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(50, 20000),
+						},
+						"total": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+			"message_configuration": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"message_configuration", "template_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adm_message":     campaignMessageSchema(),
+						"apns_message":    campaignMessageSchema(),
+						"baidu_message":   campaignMessageSchema(),
+						"default_message": campaignMessageSchema(),
+						"email_message": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"from_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"html_body": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"gcm_message": campaignMessageSchema(),
+						"sms_message": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"body": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"entity_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"message_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.MessageType_Values(), false),
+									},
+									"origination_number": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sender_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"template_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"event_filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"attributes": attributeDimensionSchema(),
+												"event_type": setDimensionSchema(),
+											},
+										},
+									},
+									"filter_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.FilterType_Values(), false),
+									},
+								},
+							},
+						},
+						"frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Frequency_Values(), false),
+						},
+						"is_local_time": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"quiet_time": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"start": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"template_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_template": campaignTemplateSchema(),
+						"push_template":  campaignTemplateSchema(),
+						"sms_template":   campaignTemplateSchema(),
+						"voice_template": campaignTemplateSchema(),
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func campaignMessageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(pinpoint.Action_Values(), false),
+				},
+				"body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_small_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"json_body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"media_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"raw_content": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"silent_push": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"time_to_live": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func campaignTemplateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceCampaignCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+	input := &pinpoint.CreateCampaignInput{
+		ApplicationId:        aws.String(applicationID),
+		WriteCampaignRequest: expandWriteCampaignRequest(d),
+	}
+
+	if len(tags) > 0 {
+		input.WriteCampaignRequest.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Campaign: %s", input)
+	output, err := conn.CreateCampaign(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Campaign (%s) for application %s: %w", name, applicationID, err)
+	}
+
+	d.SetId(CampaignCreateResourceID(applicationID, aws.StringValue(output.CampaignResponse.Id)))
+
+	return resourceCampaignRead(d, meta)
+}
+
+func resourceCampaignRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	applicationID, campaignID, err := CampaignParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	campaign, err := FindCampaignByTwoPartKey(conn, applicationID, campaignID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Campaign (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(campaign.Arn)
+	d.Set("application_id", campaign.ApplicationId)
+	d.Set("arn", arn)
+	d.Set("campaign_id", campaign.Id)
+	if campaign.State != nil {
+		d.Set("campaign_status", campaign.State.CampaignStatus)
+	} else {
+		d.Set("campaign_status", nil)
+	}
+	d.Set("description", campaign.Description)
+	d.Set("holdout_percent", campaign.HoldoutPercent)
+	if campaign.Hook != nil {
+		if err := d.Set("hook", flattenCampaignHook(campaign.Hook)); err != nil {
+			return fmt.Errorf("error setting hook: %w", err)
+		}
+	} else {
+		d.Set("hook", nil)
+	}
+	d.Set("is_paused", campaign.IsPaused)
+	if campaign.Limits != nil {
+		if err := d.Set("limits", flattenCampaignLimits(campaign.Limits)); err != nil {
+			return fmt.Errorf("error setting limits: %w", err)
+		}
+	} else {
+		d.Set("limits", nil)
+	}
+	if err := d.Set("message_configuration", flattenMessageConfiguration(campaign.MessageConfiguration)); err != nil {
+		return fmt.Errorf("error setting message_configuration: %w", err)
+	}
+	d.Set("name", campaign.Name)
+	d.Set("priority", campaign.Priority)
+	if err := d.Set("schedule", flattenSchedule(campaign.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %w", err)
+	}
+	d.Set("segment_id", campaign.SegmentId)
+	d.Set("segment_version", campaign.SegmentVersion)
+	if err := d.Set("template_configuration", flattenTemplateConfiguration(campaign.TemplateConfiguration)); err != nil {
+		return fmt.Errorf("error setting template_configuration: %w", err)
+	}
+	d.Set("version", campaign.Version)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Pinpoint Campaign (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceCampaignUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		applicationID, campaignID, err := CampaignParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &pinpoint.UpdateCampaignInput{
+			ApplicationId:        aws.String(applicationID),
+			CampaignId:           aws.String(campaignID),
+			WriteCampaignRequest: expandWriteCampaignRequest(d),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Campaign: %s", input)
+		_, err = conn.UpdateCampaign(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Campaign (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Pinpoint Campaign (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceCampaignRead(d, meta)
+}
+
+func resourceCampaignDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID, campaignID, err := CampaignParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Campaign: %s", d.Id())
+	_, err = conn.DeleteCampaign(&pinpoint.DeleteCampaignInput{
+		ApplicationId: aws.String(applicationID),
+		CampaignId:    aws.String(campaignID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Campaign (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const campaignResourceIDSeparator = "/"
+
+func CampaignCreateResourceID(applicationID, campaignID string) string {
+	parts := []string{applicationID, campaignID}
+	id := strings.Join(parts, campaignResourceIDSeparator)
+
+	return id
+}
+
+func CampaignParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, campaignResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sCAMPAIGN-ID", id, campaignResourceIDSeparator)
+}
+
+func expandWriteCampaignRequest(d *schema.ResourceData) *pinpoint.WriteCampaignRequest {
+	apiObject := &pinpoint.WriteCampaignRequest{
+		IsPaused:  aws.Bool(d.Get("is_paused").(bool)),
+		Name:      aws.String(d.Get("name").(string)),
+		SegmentId: aws.String(d.Get("segment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		apiObject.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("holdout_percent"); ok {
+		apiObject.HoldoutPercent = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("hook"); ok {
+		apiObject.Hook = expandCampaignHook(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("limits"); ok {
+		apiObject.Limits = expandCampaignLimits(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("message_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.MessageConfiguration = expandMessageConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		apiObject.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("schedule"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Schedule = expandSchedule(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_version"); ok {
+		apiObject.SegmentVersion = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("template_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TemplateConfiguration = expandTemplateConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSchedule(tfMap map[string]interface{}) *pinpoint.Schedule {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.Schedule{}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		apiObject.EndTime = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventFilter = expandCampaignEventFilter(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["frequency"].(string); ok && v != "" {
+		apiObject.Frequency = aws.String(v)
+	}
+
+	if v, ok := tfMap["is_local_time"].(bool); ok {
+		apiObject.IsLocalTime = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["quiet_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.QuietTime = expandQuietTime(v)
+	}
+
+	if v, ok := tfMap["start_time"].(string); ok && v != "" {
+		apiObject.StartTime = aws.String(v)
+	}
+
+	if v, ok := tfMap["timezone"].(string); ok && v != "" {
+		apiObject.Timezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCampaignEventFilter(tfMap map[string]interface{}) *pinpoint.CampaignEventFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.CampaignEventFilter{}
+
+	if v, ok := tfMap["dimensions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		dimensions := &pinpoint.EventDimensions{}
+
+		if v, ok := tfMap["attributes"].(*schema.Set); ok && v.Len() > 0 {
+			dimensions.Attributes = expandAttributeDimensions(v.List())
+		}
+
+		if v, ok := tfMap["event_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			dimensions.EventType = expandSetDimension(v[0].(map[string]interface{}))
+		}
+
+		apiObject.Dimensions = dimensions
+	}
+
+	if v, ok := tfMap["filter_type"].(string); ok && v != "" {
+		apiObject.FilterType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMessageConfiguration(tfMap map[string]interface{}) *pinpoint.MessageConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.MessageConfiguration{}
+
+	if v, ok := tfMap["adm_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ADMMessage = expandMessage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["apns_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.APNSMessage = expandMessage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["baidu_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BaiduMessage = expandMessage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["default_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DefaultMessage = expandMessage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["email_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		emailMessage := &pinpoint.CampaignEmailMessage{}
+
+		if v, ok := tfMap["body"].(string); ok && v != "" {
+			emailMessage.Body = aws.String(v)
+		}
+
+		if v, ok := tfMap["from_address"].(string); ok && v != "" {
+			emailMessage.FromAddress = aws.String(v)
+		}
+
+		if v, ok := tfMap["html_body"].(string); ok && v != "" {
+			emailMessage.HtmlBody = aws.String(v)
+		}
+
+		if v, ok := tfMap["title"].(string); ok && v != "" {
+			emailMessage.Title = aws.String(v)
+		}
+
+		apiObject.EmailMessage = emailMessage
+	}
+
+	if v, ok := tfMap["gcm_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GCMMessage = expandMessage(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sms_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		smsMessage := &pinpoint.CampaignSmsMessage{}
+
+		if v, ok := tfMap["body"].(string); ok && v != "" {
+			smsMessage.Body = aws.String(v)
+		}
+
+		if v, ok := tfMap["entity_id"].(string); ok && v != "" {
+			smsMessage.EntityId = aws.String(v)
+		}
+
+		if v, ok := tfMap["message_type"].(string); ok && v != "" {
+			smsMessage.MessageType = aws.String(v)
+		}
+
+		if v, ok := tfMap["origination_number"].(string); ok && v != "" {
+			smsMessage.OriginationNumber = aws.String(v)
+		}
+
+		if v, ok := tfMap["sender_id"].(string); ok && v != "" {
+			smsMessage.SenderId = aws.String(v)
+		}
+
+		if v, ok := tfMap["template_id"].(string); ok && v != "" {
+			smsMessage.TemplateId = aws.String(v)
+		}
+
+		apiObject.SMSMessage = smsMessage
+	}
+
+	return apiObject
+}
+
+func expandMessage(tfMap map[string]interface{}) *pinpoint.Message {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.Message{}
+
+	if v, ok := tfMap["action"].(string); ok && v != "" {
+		apiObject.Action = aws.String(v)
+	}
+
+	if v, ok := tfMap["body"].(string); ok && v != "" {
+		apiObject.Body = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_icon_url"].(string); ok && v != "" {
+		apiObject.ImageIconUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_small_icon_url"].(string); ok && v != "" {
+		apiObject.ImageSmallIconUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["image_url"].(string); ok && v != "" {
+		apiObject.ImageUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["json_body"].(string); ok && v != "" {
+		apiObject.JsonBody = aws.String(v)
+	}
+
+	if v, ok := tfMap["media_url"].(string); ok && v != "" {
+		apiObject.MediaUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["raw_content"].(string); ok && v != "" {
+		apiObject.RawContent = aws.String(v)
+	}
+
+	if v, ok := tfMap["silent_push"].(bool); ok && v {
+		apiObject.SilentPush = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["time_to_live"].(int); ok && v != 0 {
+		apiObject.TimeToLive = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject.Title = aws.String(v)
+	}
+
+	if v, ok := tfMap["url"].(string); ok && v != "" {
+		apiObject.Url = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTemplateConfiguration(tfMap map[string]interface{}) *pinpoint.TemplateConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.TemplateConfiguration{}
+
+	if v, ok := tfMap["email_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EmailTemplate = expandTemplate(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["push_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PushTemplate = expandTemplate(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sms_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SMSTemplate = expandTemplate(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["voice_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.VoiceTemplate = expandTemplate(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandTemplate(tfMap map[string]interface{}) *pinpoint.Template {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.Template{
+		Name: aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSchedule(apiObject *pinpoint.Schedule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"end_time":      aws.StringValue(apiObject.EndTime),
+		"frequency":     aws.StringValue(apiObject.Frequency),
+		"is_local_time": aws.BoolValue(apiObject.IsLocalTime),
+		"start_time":    aws.StringValue(apiObject.StartTime),
+		"timezone":      aws.StringValue(apiObject.Timezone),
+	}
+
+	if v := apiObject.EventFilter; v != nil {
+		eventFilter := map[string]interface{}{
+			"filter_type": aws.StringValue(v.FilterType),
+		}
+
+		if v := v.Dimensions; v != nil {
+			dimensions := map[string]interface{}{}
+
+			if v := v.Attributes; len(v) > 0 {
+				dimensions["attributes"] = flattenAttributeDimensions(v)
+			}
+
+			if v := flattenSetDimension(v.EventType); v != nil {
+				dimensions["event_type"] = v
+			}
+
+			eventFilter["dimensions"] = []interface{}{dimensions}
+		}
+
+		tfMap["event_filter"] = []interface{}{eventFilter}
+	}
+
+	if v := apiObject.QuietTime; v != nil && (aws.StringValue(v.Start) != "" || aws.StringValue(v.End) != "") {
+		tfMap["quiet_time"] = flattenQuietTime(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMessageConfiguration(apiObject *pinpoint.MessageConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := flattenMessage(apiObject.ADMMessage); v != nil {
+		tfMap["adm_message"] = v
+	}
+
+	if v := flattenMessage(apiObject.APNSMessage); v != nil {
+		tfMap["apns_message"] = v
+	}
+
+	if v := flattenMessage(apiObject.BaiduMessage); v != nil {
+		tfMap["baidu_message"] = v
+	}
+
+	if v := flattenMessage(apiObject.DefaultMessage); v != nil {
+		tfMap["default_message"] = v
+	}
+
+	if v := apiObject.EmailMessage; v != nil {
+		tfMap["email_message"] = []interface{}{map[string]interface{}{
+			"body":         aws.StringValue(v.Body),
+			"from_address": aws.StringValue(v.FromAddress),
+			"html_body":    aws.StringValue(v.HtmlBody),
+			"title":        aws.StringValue(v.Title),
+		}}
+	}
+
+	if v := flattenMessage(apiObject.GCMMessage); v != nil {
+		tfMap["gcm_message"] = v
+	}
+
+	if v := apiObject.SMSMessage; v != nil {
+		tfMap["sms_message"] = []interface{}{map[string]interface{}{
+			"body":               aws.StringValue(v.Body),
+			"entity_id":          aws.StringValue(v.EntityId),
+			"message_type":       aws.StringValue(v.MessageType),
+			"origination_number": aws.StringValue(v.OriginationNumber),
+			"sender_id":          aws.StringValue(v.SenderId),
+			"template_id":        aws.StringValue(v.TemplateId),
+		}}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMessage(apiObject *pinpoint.Message) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"action":               aws.StringValue(apiObject.Action),
+		"body":                 aws.StringValue(apiObject.Body),
+		"image_icon_url":       aws.StringValue(apiObject.ImageIconUrl),
+		"image_small_icon_url": aws.StringValue(apiObject.ImageSmallIconUrl),
+		"image_url":            aws.StringValue(apiObject.ImageUrl),
+		"json_body":            aws.StringValue(apiObject.JsonBody),
+		"media_url":            aws.StringValue(apiObject.MediaUrl),
+		"raw_content":          aws.StringValue(apiObject.RawContent),
+		"silent_push":          aws.BoolValue(apiObject.SilentPush),
+		"time_to_live":         aws.Int64Value(apiObject.TimeToLive),
+		"title":                aws.StringValue(apiObject.Title),
+		"url":                  aws.StringValue(apiObject.Url),
+	}}
+}
+
+func flattenTemplateConfiguration(apiObject *pinpoint.TemplateConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := flattenTemplate(apiObject.EmailTemplate); v != nil {
+		tfMap["email_template"] = v
+	}
+
+	if v := flattenTemplate(apiObject.PushTemplate); v != nil {
+		tfMap["push_template"] = v
+	}
+
+	if v := flattenTemplate(apiObject.SMSTemplate); v != nil {
+		tfMap["sms_template"] = v
+	}
+
+	if v := flattenTemplate(apiObject.VoiceTemplate); v != nil {
+		tfMap["voice_template"] = v
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenTemplate(apiObject *pinpoint.Template) []interface{} {
+	if apiObject == nil || apiObject.Name == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"name":    aws.StringValue(apiObject.Name),
+		"version": aws.StringValue(apiObject.Version),
+	}}
+}
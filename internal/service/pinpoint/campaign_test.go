@@ -0,0 +1,362 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointCampaign_basic(t *testing.T) {
+	var campaign pinpoint.CampaignResponse
+	resourceName := "aws_pinpoint_campaign.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexp.MustCompile(`apps/.+/campaigns/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "campaign_id"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.sms_message.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.sms_message.0.body", "Hello from Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.sms_message.0.message_type", "PROMOTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "ONCE"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.start_time", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrPair(resourceName, "segment_id", "aws_pinpoint_segment.test", "segment_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointCampaign_disappears(t *testing.T) {
+	var campaign pinpoint.CampaignResponse
+	resourceName := "aws_pinpoint_campaign.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourceCampaign(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointCampaign_update(t *testing.T) {
+	var campaign pinpoint.CampaignResponse
+	resourceName := "aws_pinpoint_campaign.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "holdout_percent", "10"),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily", "3"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.total", "10"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.sms_message.0.body", "Updated hello from Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", "WEEKLY"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.end_time", "2099-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.0.start", "22:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.0.end", "06:00"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointCampaign_tags(t *testing.T) {
+	var campaign pinpoint.CampaignResponse
+	resourceName := "aws_pinpoint_campaign.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCampaignConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignExists(n string, v *pinpoint.CampaignResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Campaign ID is set")
+		}
+
+		applicationID, campaignID, err := tfpinpoint.CampaignParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		output, err := tfpinpoint.FindCampaignByTwoPartKey(conn, applicationID, campaignID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckCampaignDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_campaign" {
+			continue
+		}
+
+		applicationID, campaignID, err := tfpinpoint.CampaignParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfpinpoint.FindCampaignByTwoPartKey(conn, applicationID, campaignID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Campaign %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCampaignConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      channel {
+        values = ["SMS"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccCampaignConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCampaignConfigBase(rName), fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  segment_id     = aws_pinpoint_segment.test.segment_id
+  is_paused      = true
+
+  message_configuration {
+    sms_message {
+      body         = "Hello from Terraform"
+      message_type = "PROMOTIONAL"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = "2099-01-01T00:00:00Z"
+  }
+}
+`, rName))
+}
+
+func testAccCampaignConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccCampaignConfigBase(rName), fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id  = aws_pinpoint_app.test.application_id
+  name            = %[1]q
+  description     = "updated"
+  segment_id      = aws_pinpoint_segment.test.segment_id
+  holdout_percent = 10
+  is_paused       = true
+
+  limits {
+    daily = 3
+    total = 10
+  }
+
+  message_configuration {
+    sms_message {
+      body         = "Updated hello from Terraform"
+      message_type = "PROMOTIONAL"
+    }
+  }
+
+  schedule {
+    frequency  = "WEEKLY"
+    start_time = "2099-01-01T00:00:00Z"
+    end_time   = "2099-06-01T00:00:00Z"
+
+    quiet_time {
+      start = "22:00"
+      end   = "06:00"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCampaignConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccCampaignConfigBase(rName), fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  segment_id     = aws_pinpoint_segment.test.segment_id
+  is_paused      = true
+
+  message_configuration {
+    sms_message {
+      body = "Hello from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = "2099-01-01T00:00:00Z"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccCampaignConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccCampaignConfigBase(rName), fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+  segment_id     = aws_pinpoint_segment.test.segment_id
+  is_paused      = true
+
+  message_configuration {
+    sms_message {
+      body = "Hello from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = "2099-01-01T00:00:00Z"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,61 @@
+package pinpoint
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCampaignByTwoPartKey(conn *pinpoint.Pinpoint, applicationID, campaignID string) (*pinpoint.CampaignResponse, error) {
+	input := &pinpoint.GetCampaignInput{
+		ApplicationId: aws.String(applicationID),
+		CampaignId:    aws.String(campaignID),
+	}
+
+	output, err := conn.GetCampaign(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CampaignResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CampaignResponse, nil
+}
+
+func FindSegmentByTwoPartKey(conn *pinpoint.Pinpoint, applicationID, segmentID string) (*pinpoint.SegmentResponse, error) {
+	input := &pinpoint.GetSegmentInput{
+		ApplicationId: aws.String(applicationID),
+		SegmentId:     aws.String(segmentID),
+	}
+
+	output, err := conn.GetSegment(input)
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SegmentResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SegmentResponse, nil
+}
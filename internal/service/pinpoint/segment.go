@@ -0,0 +1,868 @@
+package pinpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceSegment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSegmentCreate,
+		Read:   resourceSegmentRead,
+		Update: resourceSegmentUpdate,
+		Delete: resourceSegmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dimensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     segmentDimensionsSchema(),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"groups": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimensions": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     segmentDimensionsSchema(),
+									},
+									"source_segments": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"version": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"source_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.SourceType_Values(), false),
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.Type_Values(), false),
+									},
+								},
+							},
+						},
+						"include": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(pinpoint.Include_Values(), false),
+						},
+					},
+				},
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func segmentDimensionsSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"attributes": attributeDimensionSchema(),
+			"behavior": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recency": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"duration": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.Duration_Values(), false),
+									},
+									"recency_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(pinpoint.RecencyType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"demographic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_version": setDimensionSchema(),
+						"channel":     setDimensionSchema(),
+						"device_type": setDimensionSchema(),
+						"make":        setDimensionSchema(),
+						"model":       setDimensionSchema(),
+						"platform":    setDimensionSchema(),
+					},
+				},
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": setDimensionSchema(),
+						"gps_point": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"coordinates": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"latitude": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(-90, 90),
+												},
+												"longitude": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(-180, 180),
+												},
+											},
+										},
+									},
+									"range_in_kilometers": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"user_attributes": attributeDimensionSchema(),
+		},
+	}
+}
+
+func attributeDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pinpoint.AttributeTypeInclusive,
+					ValidateFunc: validation.StringInSlice(pinpoint.AttributeType_Values(), false),
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func setDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dimension_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      pinpoint.DimensionTypeInclusive,
+					ValidateFunc: validation.StringInSlice(pinpoint.DimensionType_Values(), false),
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceSegmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+	input := &pinpoint.CreateSegmentInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteSegmentRequest: expandWriteSegmentRequest(d),
+	}
+
+	if len(tags) > 0 {
+		input.WriteSegmentRequest.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Segment: %s", input)
+	output, err := conn.CreateSegment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Segment (%s) for application %s: %w", name, applicationID, err)
+	}
+
+	d.SetId(SegmentCreateResourceID(applicationID, aws.StringValue(output.SegmentResponse.Id)))
+
+	return resourceSegmentRead(d, meta)
+}
+
+func resourceSegmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	applicationID, segmentID, err := SegmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	segment, err := FindSegmentByTwoPartKey(conn, applicationID, segmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Pinpoint Segment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Segment (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(segment.Arn)
+	d.Set("application_id", segment.ApplicationId)
+	d.Set("arn", arn)
+	if err := d.Set("dimensions", flattenSegmentDimensionsList(segment.Dimensions)); err != nil {
+		return fmt.Errorf("error setting dimensions: %w", err)
+	}
+	d.Set("name", segment.Name)
+	if err := d.Set("segment_groups", flattenSegmentGroupList(segment.SegmentGroups)); err != nil {
+		return fmt.Errorf("error setting segment_groups: %w", err)
+	}
+	d.Set("segment_id", segment.Id)
+	d.Set("segment_type", segment.SegmentType)
+	d.Set("version", segment.Version)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Pinpoint Segment (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceSegmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		applicationID, segmentID, err := SegmentParseResourceID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		input := &pinpoint.UpdateSegmentInput{
+			ApplicationId:       aws.String(applicationID),
+			SegmentId:           aws.String(segmentID),
+			WriteSegmentRequest: expandWriteSegmentRequest(d),
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Segment: %s", input)
+		_, err = conn.UpdateSegment(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Segment (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Pinpoint Segment (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSegmentRead(d, meta)
+}
+
+func resourceSegmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).PinpointConn
+
+	applicationID, segmentID, err := SegmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Pinpoint Segment: %s", d.Id())
+	_, err = conn.DeleteSegment(&pinpoint.DeleteSegmentInput{
+		ApplicationId: aws.String(applicationID),
+		SegmentId:     aws.String(segmentID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, pinpoint.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Segment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const segmentResourceIDSeparator = "/"
+
+func SegmentCreateResourceID(applicationID, segmentID string) string {
+	parts := []string{applicationID, segmentID}
+	id := strings.Join(parts, segmentResourceIDSeparator)
+
+	return id
+}
+
+func SegmentParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, segmentResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sSEGMENT-ID", id, segmentResourceIDSeparator)
+}
+
+func expandWriteSegmentRequest(d *schema.ResourceData) *pinpoint.WriteSegmentRequest {
+	apiObject := &pinpoint.WriteSegmentRequest{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("dimensions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Dimensions = expandSegmentDimensions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("segment_groups"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.SegmentGroups = expandSegmentGroupList(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSegmentDimensions(tfMap map[string]interface{}) *pinpoint.SegmentDimensions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentDimensions{}
+
+	if v, ok := tfMap["attributes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Attributes = expandAttributeDimensions(v.List())
+	}
+
+	if v, ok := tfMap["behavior"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Behavior = expandSegmentBehaviors(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["demographic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Demographic = expandSegmentDemographics(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandSegmentLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["user_attributes"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.UserAttributes = expandAttributeDimensions(v.List())
+	}
+
+	return apiObject
+}
+
+func expandAttributeDimensions(tfList []interface{}) map[string]*pinpoint.AttributeDimension {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*pinpoint.AttributeDimension)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &pinpoint.AttributeDimension{}
+
+		if v, ok := tfMap["attribute_type"].(string); ok && v != "" {
+			apiObject.AttributeType = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Values = flex.ExpandStringSet(v)
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandSegmentBehaviors(tfMap map[string]interface{}) *pinpoint.SegmentBehaviors {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentBehaviors{}
+
+	if v, ok := tfMap["recency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Recency = &pinpoint.RecencyDimension{
+			Duration:    aws.String(tfMap["duration"].(string)),
+			RecencyType: aws.String(tfMap["recency_type"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSegmentDemographics(tfMap map[string]interface{}) *pinpoint.SegmentDemographics {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentDemographics{}
+
+	if v, ok := tfMap["app_version"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AppVersion = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["channel"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Channel = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["device_type"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DeviceType = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["make"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Make = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["model"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Model = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["platform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Platform = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandSegmentLocation(tfMap map[string]interface{}) *pinpoint.SegmentLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentLocation{}
+
+	if v, ok := tfMap["country"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Country = expandSetDimension(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["gps_point"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		gpsPoint := &pinpoint.GPSPointDimension{}
+
+		if v, ok := tfMap["coordinates"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			gpsPoint.Coordinates = &pinpoint.GPSCoordinates{
+				Latitude:  aws.Float64(tfMap["latitude"].(float64)),
+				Longitude: aws.Float64(tfMap["longitude"].(float64)),
+			}
+		}
+
+		if v, ok := tfMap["range_in_kilometers"].(float64); ok && v != 0 {
+			gpsPoint.RangeInKilometers = aws.Float64(v)
+		}
+
+		apiObject.GPSPoint = gpsPoint
+	}
+
+	return apiObject
+}
+
+func expandSetDimension(tfMap map[string]interface{}) *pinpoint.SetDimension {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SetDimension{}
+
+	if v, ok := tfMap["dimension_type"].(string); ok && v != "" {
+		apiObject.DimensionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Values = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandSegmentGroupList(tfMap map[string]interface{}) *pinpoint.SegmentGroupList {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &pinpoint.SegmentGroupList{}
+
+	if v, ok := tfMap["groups"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Groups = append(apiObject.Groups, expandSegmentGroup(tfMap))
+		}
+	}
+
+	if v, ok := tfMap["include"].(string); ok && v != "" {
+		apiObject.Include = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSegmentGroup(tfMap map[string]interface{}) *pinpoint.SegmentGroup {
+	apiObject := &pinpoint.SegmentGroup{}
+
+	if v, ok := tfMap["dimensions"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Dimensions = append(apiObject.Dimensions, expandSegmentDimensions(tfMap))
+		}
+	}
+
+	if v, ok := tfMap["source_segments"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			reference := &pinpoint.SegmentReference{
+				Id: aws.String(tfMap["id"].(string)),
+			}
+
+			if v, ok := tfMap["version"].(int); ok && v != 0 {
+				reference.Version = aws.Int64(int64(v))
+			}
+
+			apiObject.SourceSegments = append(apiObject.SourceSegments, reference)
+		}
+	}
+
+	if v, ok := tfMap["source_type"].(string); ok && v != "" {
+		apiObject.SourceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSegmentDimensionsList(apiObject *pinpoint.SegmentDimensions) []interface{} {
+	tfMap := flattenSegmentDimensions(apiObject)
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSegmentDimensions(apiObject *pinpoint.SegmentDimensions) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attributes; len(v) > 0 {
+		tfMap["attributes"] = flattenAttributeDimensions(v)
+	}
+
+	if v := apiObject.Behavior; v != nil && v.Recency != nil {
+		tfMap["behavior"] = []interface{}{map[string]interface{}{
+			"recency": []interface{}{map[string]interface{}{
+				"duration":     aws.StringValue(v.Recency.Duration),
+				"recency_type": aws.StringValue(v.Recency.RecencyType),
+			}},
+		}}
+	}
+
+	if v := flattenSegmentDemographics(apiObject.Demographic); len(v) > 0 {
+		tfMap["demographic"] = []interface{}{v}
+	}
+
+	if v := flattenSegmentLocation(apiObject.Location); len(v) > 0 {
+		tfMap["location"] = []interface{}{v}
+	}
+
+	if v := apiObject.UserAttributes; len(v) > 0 {
+		tfMap["user_attributes"] = flattenAttributeDimensions(v)
+	}
+
+	return tfMap
+}
+
+func flattenAttributeDimensions(apiObjects map[string]*pinpoint.AttributeDimension) []interface{} {
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_type": aws.StringValue(apiObject.AttributeType),
+			"name":           name,
+			"values":         aws.StringValueSlice(apiObject.Values),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSegmentDemographics(apiObject *pinpoint.SegmentDemographics) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := flattenSetDimension(apiObject.AppVersion); v != nil {
+		tfMap["app_version"] = v
+	}
+
+	if v := flattenSetDimension(apiObject.Channel); v != nil {
+		tfMap["channel"] = v
+	}
+
+	if v := flattenSetDimension(apiObject.DeviceType); v != nil {
+		tfMap["device_type"] = v
+	}
+
+	if v := flattenSetDimension(apiObject.Make); v != nil {
+		tfMap["make"] = v
+	}
+
+	if v := flattenSetDimension(apiObject.Model); v != nil {
+		tfMap["model"] = v
+	}
+
+	if v := flattenSetDimension(apiObject.Platform); v != nil {
+		tfMap["platform"] = v
+	}
+
+	return tfMap
+}
+
+func flattenSegmentLocation(apiObject *pinpoint.SegmentLocation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := flattenSetDimension(apiObject.Country); v != nil {
+		tfMap["country"] = v
+	}
+
+	if v := apiObject.GPSPoint; v != nil {
+		gpsPoint := map[string]interface{}{
+			"range_in_kilometers": aws.Float64Value(v.RangeInKilometers),
+		}
+
+		if v := v.Coordinates; v != nil {
+			gpsPoint["coordinates"] = []interface{}{map[string]interface{}{
+				"latitude":  aws.Float64Value(v.Latitude),
+				"longitude": aws.Float64Value(v.Longitude),
+			}}
+		}
+
+		tfMap["gps_point"] = []interface{}{gpsPoint}
+	}
+
+	return tfMap
+}
+
+func flattenSetDimension(apiObject *pinpoint.SetDimension) []interface{} {
+	if apiObject == nil || len(apiObject.Values) == 0 {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dimension_type": aws.StringValue(apiObject.DimensionType),
+		"values":         aws.StringValueSlice(apiObject.Values),
+	}}
+}
+
+func flattenSegmentGroupList(apiObject *pinpoint.SegmentGroupList) []interface{} {
+	if apiObject == nil || len(apiObject.Groups) == 0 {
+		return nil
+	}
+
+	var groups []interface{}
+
+	for _, apiObject := range apiObject.Groups {
+		if apiObject == nil {
+			continue
+		}
+
+		var dimensions []interface{}
+
+		for _, apiObject := range apiObject.Dimensions {
+			if v := flattenSegmentDimensions(apiObject); len(v) > 0 {
+				dimensions = append(dimensions, v)
+			}
+		}
+
+		var sourceSegments []interface{}
+
+		for _, apiObject := range apiObject.SourceSegments {
+			if apiObject == nil {
+				continue
+			}
+
+			sourceSegments = append(sourceSegments, map[string]interface{}{
+				"id":      aws.StringValue(apiObject.Id),
+				"version": aws.Int64Value(apiObject.Version),
+			})
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"dimensions":      dimensions,
+			"source_segments": sourceSegments,
+			"source_type":     aws.StringValue(apiObject.SourceType),
+			"type":            aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"groups":  groups,
+		"include": aws.StringValue(apiObject.Include),
+	}}
+}
@@ -0,0 +1,389 @@
+package pinpoint_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccPinpointSegment_basic(t *testing.T) {
+	var segment pinpoint.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "mobiletargeting", regexp.MustCompile(`apps/.+/segments/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.0.dimension_type", "INCLUSIVE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "dimensions.0.demographic.0.platform.0.values.*", "ios"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "segment_id"),
+					resource.TestCheckResourceAttr(resourceName, "segment_type", "DIMENSIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentConfig_basic(rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_disappears(t *testing.T) {
+	var segment pinpoint.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					acctest.CheckResourceDisappears(acctest.Provider, tfpinpoint.ResourceSegment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_dimensions(t *testing.T) {
+	var segment pinpoint.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_dimensions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.attributes.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "dimensions.0.attributes.*", map[string]string{
+						"attribute_type": "INCLUSIVE",
+						"name":           "interests",
+						"values.#":       "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.duration", "DAY_30"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.recency_type", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.location.0.country.0.dimension_type", "EXCLUSIVE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "dimensions.0.location.0.country.0.values.*", "CA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_segmentGroups(t *testing.T) {
+	var segment pinpoint.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_segmentGroups(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.include", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.groups.0.type", "ANY"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.groups.0.dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_groups.0.groups.0.dimensions.0.demographic.0.channel.0.values.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPinpointSegment_tags(t *testing.T) {
+	var segment pinpoint.SegmentResponse
+	resourceName := "aws_pinpoint_segment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheckApp(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, pinpoint.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSegmentConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSegmentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSegmentConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSegmentExists(n string, v *pinpoint.SegmentResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Segment ID is set")
+		}
+
+		applicationID, segmentID, err := tfpinpoint.SegmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+		output, err := tfpinpoint.FindSegmentByTwoPartKey(conn, applicationID, segmentID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSegmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).PinpointConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_segment" {
+			continue
+		}
+
+		applicationID, segmentID, err := tfpinpoint.SegmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfpinpoint.FindSegmentByTwoPartKey(conn, applicationID, segmentID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Segment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccSegmentConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ios"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSegmentConfig_dimensions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions {
+    attributes {
+      name   = "interests"
+      values = ["music", "sports"]
+    }
+
+    behavior {
+      recency {
+        duration     = "DAY_30"
+        recency_type = "ACTIVE"
+      }
+    }
+
+    location {
+      country {
+        dimension_type = "EXCLUSIVE"
+        values         = ["CA"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSegmentConfig_segmentGroups(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  segment_groups {
+    include = "ALL"
+
+    groups {
+      type = "ANY"
+
+      dimensions {
+        demographic {
+          channel {
+            values = ["EMAIL"]
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccSegmentConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ios"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSegmentConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = aws_pinpoint_app.test.application_id
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ios"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
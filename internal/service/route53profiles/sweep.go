@@ -0,0 +1,197 @@
+//go:build sweep
+// +build sweep
+
+package route53profiles
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53profiles"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_route53profiles_association", &resource.Sweeper{
+		Name: "aws_route53profiles_association",
+		F:    sweepAssociations,
+	})
+
+	resource.AddTestSweepers("aws_route53profiles_profile", &resource.Sweeper{
+		Name: "aws_route53profiles_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_route53profiles_association",
+			"aws_route53profiles_resource_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_route53profiles_resource_association", &resource.Sweeper{
+		Name: "aws_route53profiles_resource_association",
+		F:    sweepResourceAssociations,
+	})
+}
+
+func sweepAssociations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).Route53ProfilesConn
+	input := &route53profiles.ListProfileAssociationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListProfileAssociationsPages(input, func(page *route53profiles.ListProfileAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProfileAssociations {
+			r := ResourceAssociation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+			d.Set("profile_id", v.ProfileId)
+			d.Set("resource_id", v.ResourceId)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 Profile Association sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Profile Associations (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 Profile Associations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).Route53ProfilesConn
+	input := &route53profiles.ListProfilesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListProfilesPages(input, func(page *route53profiles.ListProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProfileSummaries {
+			if aws.StringValue(v.ShareStatus) == route53profiles.ShareStatusSharedWithMe {
+				continue
+			}
+
+			r := ResourceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Route 53 Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Route 53 Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepResourceAssociations(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).Route53ProfilesConn
+	input := &route53profiles.ListProfilesInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListProfilesPages(input, func(page *route53profiles.ListProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ProfileSummaries {
+			if aws.StringValue(v.ShareStatus) == route53profiles.ShareStatusSharedWithMe {
+				continue
+			}
+
+			input := &route53profiles.ListProfileResourceAssociationsInput{
+				ProfileId: v.Id,
+			}
+
+			err := conn.ListProfileResourceAssociationsPages(input, func(page *route53profiles.ListProfileResourceAssociationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.ProfileResourceAssociations {
+					r := ResourceResourceAssociation()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.Id))
+					d.Set("profile_id", v.ProfileId)
+					d.Set("resource_arn", v.ResourceArn)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Route 53 Profile (%s) Resource Associations (%s): %w", aws.StringValue(v.Id), region, err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 Profile Resource Association sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Route 53 Profiles (%s): %w", region, err))
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Route 53 Profile Resource Associations (%s): %w", region, err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -0,0 +1,141 @@
+package route53resolver
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConfigCreate,
+		Read:   resourceConfigRead,
+		Update: resourceConfigUpdate,
+		Delete: resourceConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autodefined_reverse_flag": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					route53resolver.AutodefinedReverseFlagEnable,
+					route53resolver.AutodefinedReverseFlagDisable,
+				}, false),
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	resourceID := d.Get("resource_id").(string)
+
+	if err := updateResolverConfigAutodefinedReverse(conn, resourceID, d.Get("autodefined_reverse_flag").(string)); err != nil {
+		return fmt.Errorf("error creating Route 53 Resolver config (%s): %w", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return resourceConfigRead(d, meta)
+}
+
+func resourceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	config, err := FindResolverConfigByResourceID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Resolver config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Route 53 Resolver config (%s): %w", d.Id(), err)
+	}
+
+	var flag string
+	switch aws.StringValue(config.AutodefinedReverse) {
+	case route53resolver.ResolverAutodefinedReverseStatusEnabled:
+		flag = route53resolver.AutodefinedReverseFlagEnable
+	case route53resolver.ResolverAutodefinedReverseStatusDisabled:
+		flag = route53resolver.AutodefinedReverseFlagDisable
+	}
+
+	d.Set("autodefined_reverse_flag", flag)
+	d.Set("owner_id", config.OwnerId)
+	d.Set("resource_id", config.ResourceId)
+
+	return nil
+}
+
+func resourceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	if d.HasChange("autodefined_reverse_flag") {
+		if err := updateResolverConfigAutodefinedReverse(conn, d.Id(), d.Get("autodefined_reverse_flag").(string)); err != nil {
+			return fmt.Errorf("error updating Route 53 Resolver config (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceConfigRead(d, meta)
+}
+
+func resourceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+	// Resolver configs cannot be deleted, so restore the default of autodefined reverse DNS rules being enabled.
+	err := updateResolverConfigAutodefinedReverse(conn, d.Id(), route53resolver.AutodefinedReverseFlagEnable)
+
+	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route 53 Resolver config (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateResolverConfigAutodefinedReverse(conn *route53resolver.Route53Resolver, resourceID, flag string) error {
+	input := &route53resolver.UpdateResolverConfigInput{
+		AutodefinedReverseFlag: aws.String(flag),
+		ResourceId:             aws.String(resourceID),
+	}
+
+	log.Printf("[DEBUG] Updating Route 53 Resolver config: %#v", input)
+	_, err := conn.UpdateResolverConfig(input)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitResolverConfigUpdated(conn, resourceID); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
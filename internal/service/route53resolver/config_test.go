@@ -0,0 +1,125 @@
+package route53resolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53resolver "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRoute53ResolverConfig_basic(t *testing.T) {
+	var v route53resolver.ResolverConfig
+	resourceName := "aws_route53_resolver_config.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_basic(rName, "DISABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "autodefined_reverse_flag", "DISABLE"),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", vpcResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_basic(rName, "ENABLE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "autodefined_reverse_flag", "ENABLE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_resolver_config" {
+			continue
+		}
+
+		config, err := tfroute53resolver.FindResolverConfigByResourceID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(config.AutodefinedReverse) == route53resolver.ResolverAutodefinedReverseStatusEnabled {
+			continue
+		}
+
+		return fmt.Errorf("Route 53 Resolver config still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigExists(n string, v *route53resolver.ResolverConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Resolver config ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+
+		out, err := tfroute53resolver.FindResolverConfigByResourceID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *out
+
+		return nil
+	}
+}
+
+func testAccConfigConfig_basic(rName, autodefinedReverseFlag string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_support   = true
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_resolver_config" "test" {
+  resource_id              = aws_vpc.test.id
+  autodefined_reverse_flag = %[2]q
+}
+`, rName, autodefinedReverseFlag)
+}
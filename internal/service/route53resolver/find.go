@@ -3,7 +3,9 @@ package route53resolver
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindResolverQueryLogConfigAssociationByID returns the query logging configuration association corresponding to the specified ID.
@@ -208,3 +210,30 @@ func FindFirewallRuleGroupAssociationByID(conn *route53resolver.Route53Resolver,
 
 	return output.FirewallRuleGroupAssociation, nil
 }
+
+// FindResolverConfigByResourceID returns the Resolver configuration corresponding to the specified resource (VPC) ID.
+// Returns NotFoundError if no configuration is found.
+func FindResolverConfigByResourceID(conn *route53resolver.Route53Resolver, resourceID string) (*route53resolver.ResolverConfig, error) {
+	input := &route53resolver.GetResolverConfigInput{
+		ResourceId: aws.String(resourceID),
+	}
+
+	output, err := conn.GetResolverConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ResolverConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ResolverConfig, nil
+}
@@ -56,8 +56,12 @@ func expandRuleTargetIPs(vTargetIps *schema.Set) []*route53resolver.TargetAddres
 		if vIp, ok := mTargetIp["ip"].(string); ok && vIp != "" {
 			targetAddress.Ip = aws.String(vIp)
 		}
+		vProtocol, _ := mTargetIp["protocol"].(string)
+		if vProtocol != "" {
+			targetAddress.Protocol = aws.String(vProtocol)
+		}
 		if vPort, ok := mTargetIp["port"].(int); ok {
-			targetAddress.Port = aws.Int64(int64(vPort))
+			targetAddress.Port = aws.Int64(int64(ruleTargetIPPort(vProtocol, vPort)))
 		}
 
 		targetAddresses = append(targetAddresses, targetAddress)
@@ -95,8 +99,9 @@ func flattenRuleTargetIPs(targetAddresses []*route53resolver.TargetAddress) []in
 
 	for _, targetAddress := range targetAddresses {
 		mTargetIp := map[string]interface{}{
-			"ip":   aws.StringValue(targetAddress.Ip),
-			"port": int(aws.Int64Value(targetAddress.Port)),
+			"ip":       aws.StringValue(targetAddress.Ip),
+			"port":     int(aws.Int64Value(targetAddress.Port)),
+			"protocol": aws.StringValue(targetAddress.Protocol),
 		}
 
 		vTargetIps = append(vTargetIps, mTargetIp)
@@ -95,9 +95,15 @@ func ResourceRule() *schema.Resource {
 						"port": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      53,
+							Computed:     true,
 							ValidateFunc: validation.IntBetween(1, 65535),
 						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      route53resolver.ProtocolDo53,
+							ValidateFunc: validation.StringInSlice(route53resolver.Protocol_Values(), false),
+						},
 					},
 				},
 				Set: ruleHashTargetIP,
@@ -281,6 +287,20 @@ func resourceRuleDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if v, ok := diff.GetOk("target_ip"); ok {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if err := validRuleTargetIPProtocolPort(tfMap["protocol"].(string), tfMap["port"].(int)); err != nil {
+				return fmt.Errorf("target_ip (%s): %w", tfMap["ip"].(string), err)
+			}
+		}
+	}
+
 	if diff.Id() != "" {
 		if diff.HasChange("resolver_endpoint_id") {
 			if _, n := diff.GetChange("resolver_endpoint_id"); n.(string) == "" {
@@ -333,10 +353,29 @@ func RuleWaitUntilTargetState(conn *route53resolver.Route53Resolver, ruleId stri
 func ruleHashTargetIP(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-%d-", m["ip"].(string), m["port"].(int)))
+	protocol, _ := m["protocol"].(string)
+	buf.WriteString(fmt.Sprintf("%s-%d-", m["ip"].(string), ruleTargetIPPort(protocol, m["port"].(int))))
+	// Only non-default protocols are included so that existing target IPs keep their hash.
+	if protocol != "" && protocol != route53resolver.ProtocolDo53 {
+		buf.WriteString(fmt.Sprintf("%s-", protocol))
+	}
 	return create.StringHashcode(buf.String())
 }
 
+// ruleTargetIPPort returns the port to use for a target IP.
+// If no port is configured the protocol's well-known port is used.
+func ruleTargetIPPort(protocol string, port int) int {
+	if port != 0 {
+		return port
+	}
+
+	if protocol == "" || protocol == route53resolver.ProtocolDo53 {
+		return 53
+	}
+
+	return 443
+}
+
 // trimTrailingPeriod is used to remove the trailing period
 // of "name" or "domain name" attributes often returned from
 // the Route53 API or provided as user input.
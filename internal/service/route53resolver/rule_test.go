@@ -2,6 +2,7 @@ package route53resolver_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -218,8 +219,9 @@ func TestAccRoute53ResolverRule_forward(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "resolver_endpoint_id", resourceNameEp1, "id"),
 					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
-						"ip":   "192.0.2.6",
-						"port": "53",
+						"ip":       "192.0.2.6",
+						"port":     "53",
+						"protocol": "Do53",
 					}),
 				),
 			},
@@ -319,6 +321,60 @@ func TestAccRoute53ResolverRule_forwardEndpointRecreate(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverRule_forwardProtocol(t *testing.T) {
+	var rule1, rule2 route53resolver.ResolverRule
+	resourceName := "aws_route53_resolver_rule.example"
+	name := fmt.Sprintf("terraform-testacc-r53-resolver-%d", sdkacctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_forwardProtocolPort(name, "DoH", 53),
+				ExpectError: regexp.MustCompile(`port 53 is not valid with protocol "DoH"`),
+			},
+			{
+				Config: testAccRuleConfig_forwardProtocol(name, "DoH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":       "192.0.2.6",
+						"port":     "443",
+						"protocol": "DoH",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":       "192.0.2.7",
+						"port":     "53",
+						"protocol": "Do53",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuleConfig_forwardProtocolPort(name, "DoH", 8443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleExists(resourceName, &rule2),
+					testAccCheckRulesSame(&rule2, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "target_ip.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "target_ip.*", map[string]string{
+						"ip":       "192.0.2.6",
+						"port":     "8443",
+						"protocol": "DoH",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRulesSame(before, after *route53resolver.ResolverRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if *before.Arn != *after.Arn {
@@ -517,6 +573,49 @@ resource "aws_route53_resolver_rule" "example" {
 `, testAccRuleConfig_resolverEndpointRecreate(name), name)
 }
 
+func testAccRuleConfig_forwardProtocol(name, protocol string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  rule_type   = "FORWARD"
+  name        = %[2]q
+
+  resolver_endpoint_id = aws_route53_resolver_endpoint.foo.id
+
+  target_ip {
+    ip       = "192.0.2.6"
+    protocol = %[3]q
+  }
+
+  target_ip {
+    ip = "192.0.2.7"
+  }
+}
+`, testAccRuleConfig_resolverEndpoint(name), name, protocol)
+}
+
+func testAccRuleConfig_forwardProtocolPort(name, protocol string, port int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "aws_route53_resolver_rule" "example" {
+  domain_name = "example.com"
+  rule_type   = "FORWARD"
+  name        = %[2]q
+
+  resolver_endpoint_id = aws_route53_resolver_endpoint.foo.id
+
+  target_ip {
+    ip       = "192.0.2.6"
+    port     = %[4]d
+    protocol = %[3]q
+  }
+}
+`, testAccRuleConfig_resolverEndpoint(name), name, protocol, port)
+}
+
 func testAccRuleConfig_resolverVPC(name string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "foo" {
@@ -5,6 +5,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -22,6 +23,9 @@ const (
 
 	resolverFirewallRuleGroupAssociationStatusNotFound = "NotFound"
 	resolverFirewallRuleGroupAssociationStatusUnknown  = "Unknown"
+
+	resolverConfigStatusNotFound = "NotFound"
+	resolverConfigStatusUnknown  = "Unknown"
 )
 
 // StatusQueryLogConfigAssociation fetches the QueryLogConfigAssociation and its Status
@@ -128,3 +132,20 @@ func StatusFirewallRuleGroupAssociation(conn *route53resolver.Route53Resolver, f
 		return firewallRuleGroupAssociation, aws.StringValue(firewallRuleGroupAssociation.Status), nil
 	}
 }
+
+// StatusResolverConfig fetches the ResolverConfig and its AutodefinedReverse status
+func StatusResolverConfig(conn *route53resolver.Route53Resolver, resourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resolverConfig, err := FindResolverConfigByResourceID(conn, resourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, resolverConfigStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, resolverConfigStatusUnknown, err
+		}
+
+		return resolverConfig, aws.StringValue(resolverConfig.AutodefinedReverse), nil
+	}
+}
@@ -3,6 +3,8 @@ package route53resolver
 import (
 	"fmt"
 	"regexp"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
 )
 
 func validResolverName(v interface{}, k string) (ws []string, errors []error) {
@@ -27,3 +29,17 @@ func validResolverName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validRuleTargetIPProtocolPort validates the combination of a rule target IP's protocol and port.
+// DNS over HTTPS targets cannot listen on the plain DNS port.
+func validRuleTargetIPProtocolPort(protocol string, port int) error {
+	if protocol == "" || protocol == route53resolver.ProtocolDo53 {
+		return nil
+	}
+
+	if port == 53 {
+		return fmt.Errorf("port 53 is not valid with protocol %q", protocol)
+	}
+
+	return nil
+}
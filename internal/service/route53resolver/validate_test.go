@@ -43,3 +43,47 @@ func TestValidResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRuleTargetIPProtocolPort(t *testing.T) {
+	cases := []struct {
+		Protocol    string
+		Port        int
+		ExpectError bool
+	}{
+		{
+			Protocol: "Do53",
+			Port:     53,
+		},
+		{
+			Protocol: "Do53",
+			Port:     5353,
+		},
+		{
+			Protocol: "DoH",
+			Port:     443,
+		},
+		{
+			Protocol: "DoH-FIPS",
+			Port:     0,
+		},
+		{
+			Protocol:    "DoH",
+			Port:        53,
+			ExpectError: true,
+		},
+		{
+			Protocol:    "DoH-FIPS",
+			Port:        53,
+			ExpectError: true,
+		},
+	}
+	for _, tc := range cases {
+		err := validRuleTargetIPProtocolPort(tc.Protocol, tc.Port)
+		if tc.ExpectError && err == nil {
+			t.Errorf("Expected protocol %q with port %d to trigger a validation error", tc.Protocol, tc.Port)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("Expected protocol %q with port %d to not trigger a validation error: %s", tc.Protocol, tc.Port, err)
+		}
+	}
+}
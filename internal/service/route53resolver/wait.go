@@ -42,6 +42,9 @@ const (
 
 	// Maximum amount of time to wait for a FirewallRuleGroupAssociation to be deleted
 	FirewallRuleGroupAssociationDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a ResolverConfig to be updated
+	ResolverConfigUpdatedTimeout = 5 * time.Minute
 )
 
 // WaitQueryLogConfigAssociationCreated waits for a QueryLogConfig to return ACTIVE
@@ -250,3 +253,27 @@ func WaitFirewallRuleGroupAssociationDeleted(conn *route53resolver.Route53Resolv
 
 	return nil, err
 }
+
+// WaitResolverConfigUpdated waits for a ResolverConfig to return ENABLED or DISABLED
+func WaitResolverConfigUpdated(conn *route53resolver.Route53Resolver, resourceID string) (*route53resolver.ResolverConfig, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			route53resolver.ResolverAutodefinedReverseStatusEnabling,
+			route53resolver.ResolverAutodefinedReverseStatusDisabling,
+		},
+		Target: []string{
+			route53resolver.ResolverAutodefinedReverseStatusEnabled,
+			route53resolver.ResolverAutodefinedReverseStatusDisabled,
+		},
+		Refresh: StatusResolverConfig(conn, resourceID),
+		Timeout: ResolverConfigUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.ResolverConfig); ok {
+		return v, err
+	}
+
+	return nil, err
+}
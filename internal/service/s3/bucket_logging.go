@@ -87,6 +87,37 @@ func ResourceBucketLogging() *schema.Resource {
 					},
 				},
 			},
+			"target_object_key_format": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"partitioned_prefix": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition_date_source": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.PartitionDateSource_Values(), false),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"target_object_key_format.0.partitioned_prefix", "target_object_key_format.0.simple_prefix"},
+						},
+						"simple_prefix": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Elem:         &schema.Resource{},
+							ExactlyOneOf: []string{"target_object_key_format.0.partitioned_prefix", "target_object_key_format.0.simple_prefix"},
+						},
+					},
+				},
+			},
 			"target_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -110,6 +141,10 @@ func resourceBucketLoggingCreate(ctx context.Context, d *schema.ResourceData, me
 		loggingEnabled.TargetGrants = expandBucketLoggingTargetGrants(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("target_object_key_format"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		loggingEnabled.TargetObjectKeyFormat = expandBucketLoggingTargetObjectKeyFormat(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	input := &s3.PutBucketLoggingInput{
 		Bucket: aws.String(bucket),
 		BucketLoggingStatus: &s3.BucketLoggingStatus{
@@ -186,6 +221,14 @@ func resourceBucketLoggingRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("error setting target_grant: %w", err))
 	}
 
+	if loggingEnabled.TargetObjectKeyFormat != nil {
+		if err := d.Set("target_object_key_format", []interface{}{flattenBucketLoggingTargetObjectKeyFormat(loggingEnabled.TargetObjectKeyFormat)}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting target_object_key_format: %w", err))
+		}
+	} else {
+		d.Set("target_object_key_format", nil)
+	}
+
 	return nil
 }
 
@@ -206,6 +249,10 @@ func resourceBucketLoggingUpdate(ctx context.Context, d *schema.ResourceData, me
 		loggingEnabled.TargetGrants = expandBucketLoggingTargetGrants(v.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("target_object_key_format"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		loggingEnabled.TargetObjectKeyFormat = expandBucketLoggingTargetObjectKeyFormat(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	input := &s3.PutBucketLoggingInput{
 		Bucket: aws.String(bucket),
 		BucketLoggingStatus: &s3.BucketLoggingStatus{
@@ -372,3 +419,56 @@ func flattenBucketLoggingTargetGrantGrantee(g *s3.Grantee) []interface{} {
 
 	return []interface{}{m}
 }
+
+func expandBucketLoggingTargetObjectKeyFormat(tfMap map[string]interface{}) *s3.TargetObjectKeyFormat {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.TargetObjectKeyFormat{}
+
+	if v, ok := tfMap["partitioned_prefix"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.PartitionedPrefix = expandBucketLoggingPartitionedPrefix(v[0].(map[string]interface{}))
+	}
+
+	// simple_prefix is an empty configuration block, so its list element is nil.
+	if v, ok := tfMap["simple_prefix"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SimplePrefix = &s3.SimplePrefix{}
+	}
+
+	return apiObject
+}
+
+func expandBucketLoggingPartitionedPrefix(tfMap map[string]interface{}) *s3.PartitionedPrefix {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.PartitionedPrefix{}
+
+	if v, ok := tfMap["partition_date_source"].(string); ok && v != "" {
+		apiObject.PartitionDateSource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBucketLoggingTargetObjectKeyFormat(apiObject *s3.TargetObjectKeyFormat) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PartitionedPrefix; v != nil {
+		tfMap["partitioned_prefix"] = []interface{}{map[string]interface{}{
+			"partition_date_source": aws.StringValue(v.PartitionDateSource),
+		}}
+	}
+
+	if apiObject.SimplePrefix != nil {
+		tfMap["simple_prefix"] = []interface{}{map[string]interface{}{}}
+	}
+
+	return tfMap
+}
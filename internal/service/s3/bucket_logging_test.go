@@ -34,6 +34,7 @@ func TestAccS3BucketLogging_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "target_bucket", "aws_s3_bucket.log_bucket", "bucket"),
 					resource.TestCheckResourceAttr(resourceName, "target_prefix", "log/"),
 					resource.TestCheckResourceAttr(resourceName, "target_grant.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", "0"),
 				),
 			},
 			{
@@ -298,6 +299,54 @@ func TestAccS3BucketLogging_TargetGrantByGroup(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLogging_withTargetObjectKeyFormat(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_logging.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBucketLoggingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLoggingTargetObjectKeyFormatPartitionedPrefixConfig(rName, "EventTime"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.0.partition_date_source", "EventTime"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLoggingTargetObjectKeyFormatPartitionedPrefixConfig(rName, "DeliveryTime"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.0.partition_date_source", "DeliveryTime"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", "0"),
+				),
+			},
+			{
+				Config: testAccBucketLoggingTargetObjectKeyFormatSimplePrefixConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLoggingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.partitioned_prefix.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_object_key_format.0.simple_prefix.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLogging_migrate_loggingNoChange(t *testing.T) {
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bucketResourceName := "aws_s3_bucket.test"
@@ -479,6 +528,60 @@ resource "aws_s3_bucket_logging" "test" {
 `, rName)
 }
 
+func testAccBucketLoggingTargetObjectKeyFormatBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "log_bucket" {
+  bucket = "%[1]s-log"
+}
+
+resource "aws_s3_bucket_acl" "log_bucket_acl" {
+  bucket = aws_s3_bucket.log_bucket.id
+  acl    = "log-delivery-write"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+`, rName)
+}
+
+func testAccBucketLoggingTargetObjectKeyFormatPartitionedPrefixConfig(rName, partitionDateSource string) string {
+	return acctest.ConfigCompose(testAccBucketLoggingTargetObjectKeyFormatBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_logging" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  target_bucket = aws_s3_bucket.log_bucket.id
+  target_prefix = "log/"
+
+  target_object_key_format {
+    partitioned_prefix {
+      partition_date_source = %[1]q
+    }
+  }
+}
+`, partitionDateSource))
+}
+
+func testAccBucketLoggingTargetObjectKeyFormatSimplePrefixConfig(rName string) string {
+	return acctest.ConfigCompose(testAccBucketLoggingTargetObjectKeyFormatBaseConfig(rName), `
+resource "aws_s3_bucket_logging" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  target_bucket = aws_s3_bucket.log_bucket.id
+  target_prefix = "log/"
+
+  target_object_key_format {
+    simple_prefix {}
+  }
+}
+`)
+}
+
 func testAccBucketLoggingUpdateConfig(rName, targetBucketName, targetPrefix string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
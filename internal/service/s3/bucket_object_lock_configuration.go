@@ -47,9 +47,14 @@ func ResourceBucketObjectLockConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(s3.ObjectLockEnabled_Values(), false),
 			},
+			"request_payer": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.RequestPayer_Values(), false),
+			},
 			"rule": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -118,9 +123,11 @@ func resourceBucketObjectLockConfigurationCreate(ctx context.Context, d *schema.
 		input.Token = aws.String(v.(string))
 	}
 
+	// Object Lock can only be enabled on an existing bucket once versioning is enabled;
+	// retry in case a just-applied versioning configuration has not yet propagated.
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(2*time.Minute, func() (interface{}, error) {
 		return conn.PutObjectLockConfigurationWithContext(ctx, input)
-	}, s3.ErrCodeNoSuchBucket)
+	}, s3.ErrCodeNoSuchBucket, ErrCodeInvalidBucketState)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating S3 bucket (%s) Object Lock configuration: %w", bucket, err))
@@ -241,6 +248,10 @@ func resourceBucketObjectLockConfigurationDelete(ctx context.Context, d *schema.
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	if v, ok := d.GetOk("request_payer"); ok {
+		input.RequestPayer = aws.String(v.(string))
+	}
+
 	_, err = conn.PutObjectLockConfigurationWithContext(ctx, input)
 
 	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeObjectLockConfigurationNotFound) {
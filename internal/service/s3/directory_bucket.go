@@ -0,0 +1,422 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+var (
+	// e.g. example--usw2-az2--x-s3
+	directoryBucketNameRegex = regexp.MustCompile(`^([0-9a-z.-]+)--([a-z0-9-]+)--x-s3$`)
+)
+
+func ResourceDirectoryBucket() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectoryBucketCreate,
+		ReadContext:   resourceDirectoryBucketRead,
+		UpdateContext: resourceDirectoryBucketUpdate,
+		DeleteContext: resourceDirectoryBucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDirectoryBucketImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 63),
+					validation.StringMatch(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3. Use the aws_s3_bucket resource to manage general purpose buckets`),
+				),
+			},
+			"data_redundancy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.DataRedundancySingleAvailabilityZone,
+				ValidateFunc: validation.StringInSlice(s3.DataRedundancy_Values(), false),
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      s3.LocationTypeAvailabilityZone,
+							ValidateFunc: validation.StringInSlice(s3.LocationType_Values(), false),
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      s3.BucketTypeDirectory,
+				ValidateFunc: validation.StringInSlice(s3.BucketType_Values(), false),
+			},
+		},
+
+		CustomizeDiff: resourceDirectoryBucketCustomizeDiff,
+	}
+}
+
+func resourceDirectoryBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3ExpressControlConn
+
+	bucket := d.Get("bucket").(string)
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
+			Bucket: &s3.BucketInfo{
+				DataRedundancy: aws.String(d.Get("data_redundancy").(string)),
+				Type:           aws.String(d.Get("type").(string)),
+			},
+			Location: expandDirectoryBucketLocation(d.Get("location").([]interface{})),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating S3 Directory Bucket: %s", input)
+	_, err := conn.CreateBucketWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating S3 Directory Bucket (%s): %s", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceDirectoryBucketRead(ctx, d, meta)
+}
+
+func resourceDirectoryBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3ExpressControlConn
+
+	output, err := FindDirectoryBucketByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Bucket (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: client.Partition,
+		Service:   "s3express",
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("bucket/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("bucket", output.Name)
+
+	// ListDirectoryBuckets does not return the bucket configuration.
+	// The Availability Zone ID is encoded in the bucket name.
+	if _, ok := d.GetOk("location"); !ok {
+		if azID, err := directoryBucketAvailabilityZoneID(d.Id()); err == nil {
+			d.Set("location", []interface{}{
+				map[string]interface{}{
+					"name": azID,
+					"type": s3.LocationTypeAvailabilityZone,
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func resourceDirectoryBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only force_destroy can be updated, which requires no API call.
+	return resourceDirectoryBucketRead(ctx, d, meta)
+}
+
+func resourceDirectoryBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.AWSClient)
+	conn := client.S3ExpressControlConn
+
+	log.Printf("[DEBUG] Deleting S3 Directory Bucket: %s", d.Id())
+	_, err := conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeBucketNotEmpty) && d.Get("force_destroy").(bool) {
+		// Objects in directory buckets can only be managed via the Zonal endpoint.
+		zonalConn, zonalErr := DirectoryBucketZonalConn(ctx, client, d.Id())
+
+		if zonalErr != nil {
+			return diag.Errorf("emptying S3 Directory Bucket (%s): %s", d.Id(), zonalErr)
+		}
+
+		if n, err := emptyDirectoryBucket(ctx, zonalConn, d.Id()); err != nil {
+			return diag.Errorf("emptying S3 Directory Bucket (%s): %s", d.Id(), err)
+		} else {
+			log.Printf("[DEBUG] Deleted %d S3 objects", n)
+		}
+
+		_, err = tfresource.RetryWhenAWSErrCodeEquals(d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+			return conn.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
+				Bucket: aws.String(d.Id()),
+			})
+		}, ErrCodeBucketNotEmpty)
+	}
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting S3 Directory Bucket (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectoryBucketImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("data_redundancy", s3.DataRedundancySingleAvailabilityZone)
+	d.Set("force_destroy", false)
+	d.Set("type", s3.BucketTypeDirectory)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func FindDirectoryBucketByName(ctx context.Context, conn *s3.S3, name string) (*s3.Bucket, error) {
+	input := &s3.ListDirectoryBucketsInput{}
+	var output *s3.Bucket
+
+	err := conn.ListDirectoryBucketsPagesWithContext(ctx, input, func(page *s3.ListDirectoryBucketsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Buckets {
+			if v != nil && aws.StringValue(v.Name) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// directoryBucketAvailabilityZoneID returns the Availability Zone ID encoded in a directory bucket's name.
+func directoryBucketAvailabilityZoneID(bucket string) (string, error) {
+	matches := directoryBucketNameRegex.FindStringSubmatch(bucket)
+
+	if len(matches) != 3 {
+		return "", fmt.Errorf("unexpected format for S3 Directory Bucket name (%s), expected [bucket_name]--[azid]--x-s3", bucket)
+	}
+
+	return matches[2], nil
+}
+
+// DirectoryBucketZonalConn returns an S3 client for the specified directory bucket's Zonal endpoint.
+// Zonal endpoint requests are authorized with the session credentials returned by CreateSession,
+// which aws-sdk-go does not manage itself.
+func DirectoryBucketZonalConn(ctx context.Context, client *conns.AWSClient, bucket string) (*s3.S3, error) {
+	azID, err := directoryBucketAvailabilityZoneID(bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Zonal endpoints only support virtual-hosted-style requests.
+	config := &aws.Config{
+		Endpoint:         aws.String("https://" + client.RegionalHostname(fmt.Sprintf("s3express-%s", azID))),
+		S3ForcePathStyle: aws.Bool(false),
+	}
+
+	sessionConn := s3.New(client.Session.Copy(config))
+	sessionConn.SigningName = "s3express"
+
+	output, err := sessionConn.CreateSessionWithContext(ctx, &s3.CreateSessionInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("creating session: %w", err)
+	}
+
+	if output == nil || output.Credentials == nil {
+		return nil, fmt.Errorf("creating session: %w", tfresource.NewEmptyResultError(nil))
+	}
+
+	// The session token is sent in the x-amz-s3session-token header instead of the
+	// X-Amz-Security-Token header used for temporary credentials.
+	sessionToken := aws.StringValue(output.Credentials.SessionToken)
+	config.Credentials = credentials.NewStaticCredentials(aws.StringValue(output.Credentials.AccessKeyId), aws.StringValue(output.Credentials.SecretAccessKey), "")
+
+	conn := s3.New(client.Session.Copy(config))
+	conn.SigningName = "s3express"
+	conn.Handlers.Build.PushBack(func(r *request.Request) {
+		r.HTTPRequest.Header.Set("x-amz-s3session-token", sessionToken)
+	})
+
+	return conn, nil
+}
+
+// emptyDirectoryBucket deletes all objects from a directory bucket.
+// Directory buckets do not support versioning so only current objects are deleted.
+func emptyDirectoryBucket(ctx context.Context, conn *s3.S3, bucket string) (int64, error) {
+	var nObjects int64
+	var deleteErr error
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil || len(page.Contents) == 0 {
+			return !lastPage
+		}
+
+		var objectsToDelete []*s3.ObjectIdentifier
+
+		for _, v := range page.Contents {
+			objectsToDelete = append(objectsToDelete, &s3.ObjectIdentifier{
+				Key: v.Key,
+			})
+		}
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objectsToDelete,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			deleteErr = err
+
+			return false
+		}
+
+		if output != nil && len(output.Errors) > 0 {
+			deleteErr = fmt.Errorf("deleting object (%s): %s", aws.StringValue(output.Errors[0].Key), aws.StringValue(output.Errors[0].Message))
+
+			return false
+		}
+
+		nObjects += int64(len(objectsToDelete))
+
+		return !lastPage
+	})
+
+	if err == nil {
+		err = deleteErr
+	}
+
+	if err != nil {
+		return nObjects, err
+	}
+
+	return nObjects, nil
+}
+
+func resourceDirectoryBucketCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The Availability Zone ID in the bucket name must match the bucket's location.
+	if !diff.NewValueKnown("bucket") || !diff.NewValueKnown("location") {
+		return nil
+	}
+
+	location := expandDirectoryBucketLocation(diff.Get("location").([]interface{}))
+
+	if location == nil || aws.StringValue(location.Type) != s3.LocationTypeAvailabilityZone {
+		return nil
+	}
+
+	bucket := diff.Get("bucket").(string)
+	azID, err := directoryBucketAvailabilityZoneID(bucket)
+
+	if err != nil {
+		return err
+	}
+
+	if name := aws.StringValue(location.Name); name != azID {
+		return fmt.Errorf("location.0.name (%s) must match the Availability Zone ID in the bucket name (%s)", name, bucket)
+	}
+
+	return nil
+}
+
+func expandDirectoryBucketLocation(l []interface{}) *s3.LocationInfo {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	apiObject := &s3.LocationInfo{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
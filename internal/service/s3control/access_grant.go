@@ -0,0 +1,327 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessGrantCreate,
+		Read:   resourceAccessGrantRead,
+		Update: resourceAccessGrantUpdate,
+		Delete: resourceAccessGrantDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grant_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_sub_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2000),
+						},
+					},
+				},
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"grant_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"grantee": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee_identifier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"grantee_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.GranteeType_Values(), false),
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(s3control.Permission_Values(), false),
+			},
+			"s3_prefix_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(s3control.S3PrefixType_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantInput{
+		AccessGrantsLocationId: aws.String(d.Get("access_grants_location_id").(string)),
+		AccountId:              aws.String(accountID),
+		Permission:             aws.String(d.Get("permission").(string)),
+	}
+
+	if v, ok := d.GetOk("access_grants_location_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessGrantsLocationConfiguration = expandAccessGrantsLocationConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("grantee"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Grantee = expandGrantee(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("s3_prefix_type"); ok {
+		input.S3PrefixType = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = resourceTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Access Grant: %s", input)
+	output, err := conn.CreateAccessGrant(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Access Grant: %w", err)
+	}
+
+	d.SetId(AccessGrantCreateResourceID(accountID, aws.StringValue(output.AccessGrantId)))
+
+	return resourceAccessGrantRead(d, meta)
+}
+
+func resourceAccessGrantRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, grantID, err := AccessGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindAccessGrantByTwoPartKey(conn, accountID, grantID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Grant (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.AccessGrantArn)
+	d.Set("access_grant_arn", arn)
+	d.Set("access_grant_id", output.AccessGrantId)
+	if err := d.Set("access_grants_location_configuration", flattenAccessGrantsLocationConfiguration(output.AccessGrantsLocationConfiguration)); err != nil {
+		return fmt.Errorf("error setting access_grants_location_configuration: %w", err)
+	}
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("grant_scope", output.GrantScope)
+	if err := d.Set("grantee", flattenGrantee(output.Grantee)); err != nil {
+		return fmt.Errorf("error setting grantee: %w", err)
+	}
+	d.Set("permission", output.Permission)
+
+	tags, err := resourceListTags(conn, accountID, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Access Grant (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAccessGrantUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := resourceUpdateTags(conn, d.Get("account_id").(string), d.Get("access_grant_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating S3 Access Grant (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantRead(d, meta)
+}
+
+func resourceAccessGrantDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, grantID, err := AccessGrantParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grant: %s", d.Id())
+	_, err = conn.DeleteAccessGrant(&s3control.DeleteAccessGrantInput{
+		AccessGrantId: aws.String(grantID),
+		AccountId:     aws.String(accountID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantNotExistsError, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Grant (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const accessGrantResourceIDSeparator = ","
+
+func AccessGrantCreateResourceID(accountID, grantID string) string {
+	parts := []string{accountID, grantID}
+	id := strings.Join(parts, accessGrantResourceIDSeparator)
+
+	return id
+}
+
+func AccessGrantParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, accessGrantResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-grant-id", id, accessGrantResourceIDSeparator)
+}
+
+func expandAccessGrantsLocationConfiguration(tfMap map[string]interface{}) *s3control.AccessGrantsLocationConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.AccessGrantsLocationConfiguration{}
+
+	if v, ok := tfMap["s3_sub_prefix"].(string); ok && v != "" {
+		apiObject.S3SubPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGrantee(tfMap map[string]interface{}) *s3control.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.Grantee{}
+
+	if v, ok := tfMap["grantee_identifier"].(string); ok && v != "" {
+		apiObject.GranteeIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["grantee_type"].(string); ok && v != "" {
+		apiObject.GranteeType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAccessGrantsLocationConfiguration(apiObject *s3control.AccessGrantsLocationConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"s3_sub_prefix": aws.StringValue(apiObject.S3SubPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenGrantee(apiObject *s3control.Grantee) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"grantee_identifier": aws.StringValue(apiObject.GranteeIdentifier),
+		"grantee_type":       aws.StringValue(apiObject.GranteeType),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,205 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrantsInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessGrantsInstanceCreate,
+		Read:   resourceAccessGrantsInstanceRead,
+		Update: resourceAccessGrantsInstanceUpdate,
+		Delete: resourceAccessGrantsInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_instance_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"identity_center_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsInstanceInput{
+		AccountId: aws.String(accountID),
+	}
+
+	if v, ok := d.GetOk("identity_center_arn"); ok {
+		input.IdentityCenterArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = resourceTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Access Grants Instance: %s", input)
+	_, err := conn.CreateAccessGrantsInstance(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Access Grants Instance (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceAccessGrantsInstanceRead(d, meta)
+}
+
+func resourceAccessGrantsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAccessGrantsInstance(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Grants Instance (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.AccessGrantsInstanceArn)
+	d.Set("access_grants_instance_arn", arn)
+	d.Set("access_grants_instance_id", output.AccessGrantsInstanceId)
+	d.Set("account_id", d.Id())
+	d.Set("identity_center_arn", output.IdentityCenterArn)
+
+	tags, err := resourceListTags(conn, d.Id(), arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Access Grants Instance (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	if d.HasChange("identity_center_arn") {
+		o, n := d.GetChange("identity_center_arn")
+
+		if o.(string) != "" {
+			_, err := conn.DissociateAccessGrantsIdentityCenter(&s3control.DissociateAccessGrantsIdentityCenterInput{
+				AccountId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error dissociating S3 Access Grants Instance (%s) IAM Identity Center: %w", d.Id(), err)
+			}
+		}
+
+		if n.(string) != "" {
+			_, err := conn.AssociateAccessGrantsIdentityCenter(&s3control.AssociateAccessGrantsIdentityCenterInput{
+				AccountId:         aws.String(d.Id()),
+				IdentityCenterArn: aws.String(n.(string)),
+			})
+
+			if err != nil {
+				return fmt.Errorf("error associating S3 Access Grants Instance (%s) IAM Identity Center (%s): %w", d.Id(), n.(string), err)
+			}
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := resourceUpdateTags(conn, d.Id(), d.Get("access_grants_instance_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating S3 Access Grants Instance (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantsInstanceRead(d, meta)
+}
+
+func resourceAccessGrantsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	// An instance associated with an IAM Identity Center instance cannot be deleted.
+	if v, ok := d.GetOk("identity_center_arn"); ok && v.(string) != "" {
+		_, err := conn.DissociateAccessGrantsIdentityCenter(&s3control.DissociateAccessGrantsIdentityCenterInput{
+			AccountId: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error dissociating S3 Access Grants Instance (%s) IAM Identity Center: %w", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance: %s", d.Id())
+	_, err := conn.DeleteAccessGrantsInstance(&s3control.DeleteAccessGrantsInstanceInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Grants Instance (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrantsInstanceResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessGrantsInstanceResourcePolicyPut,
+		Read:   resourceAccessGrantsInstanceResourcePolicyRead,
+		Update: resourceAccessGrantsInstanceResourcePolicyPut,
+		Delete: resourceAccessGrantsInstanceResourcePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessGrantsInstanceResourcePolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get("policy").(string), err)
+	}
+
+	input := &s3control.PutAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(accountID),
+		Policy:    aws.String(policy),
+	}
+
+	log.Printf("[DEBUG] Putting S3 Access Grants Instance Resource Policy: %s", input)
+	_, err = conn.PutAccessGrantsInstanceResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Access Grants Instance Resource Policy (%s): %w", accountID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(accountID)
+	}
+
+	return resourceAccessGrantsInstanceResourcePolicyRead(d, meta)
+}
+
+func resourceAccessGrantsInstanceResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	output, err := FindAccessGrantsInstanceResourcePolicy(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Instance Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Grants Instance Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", d.Id())
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceAccessGrantsInstanceResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Instance Resource Policy: %s", d.Id())
+	_, err := conn.DeleteAccessGrantsInstanceResourcePolicy(&s3control.DeleteAccessGrantsInstanceResourcePolicyInput{
+		AccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsInstanceResourcePolicyNotExists) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Grants Instance Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
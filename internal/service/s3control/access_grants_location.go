@@ -0,0 +1,233 @@
+package s3control
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAccessGrantsLocation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccessGrantsLocationCreate,
+		Read:   resourceAccessGrantsLocationRead,
+		Update: resourceAccessGrantsLocationUpdate,
+		Delete: resourceAccessGrantsLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_grants_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_grants_location_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"iam_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"location_scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAccessGrantsLocationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateAccessGrantsLocationInput{
+		AccountId:     aws.String(accountID),
+		IAMRoleArn:    aws.String(d.Get("iam_role_arn").(string)),
+		LocationScope: aws.String(d.Get("location_scope").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = resourceTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Access Grants Location: %s", input)
+	// Handle IAM eventual consistency.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAccessGrantsLocation(input)
+	}, errCodeInvalidIAMRole)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Access Grants Location: %w", err)
+	}
+
+	d.SetId(AccessGrantsLocationCreateResourceID(accountID, aws.StringValue(outputRaw.(*s3control.CreateAccessGrantsLocationOutput).AccessGrantsLocationId)))
+
+	return resourceAccessGrantsLocationRead(d, meta)
+}
+
+func resourceAccessGrantsLocationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindAccessGrantsLocationByTwoPartKey(conn, accountID, locationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Access Grants Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Access Grants Location (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(output.AccessGrantsLocationArn)
+	d.Set("access_grants_location_arn", arn)
+	d.Set("access_grants_location_id", output.AccessGrantsLocationId)
+	d.Set("account_id", accountID)
+	d.Set("iam_role_arn", output.IAMRoleArn)
+	d.Set("location_scope", output.LocationScope)
+
+	tags, err := resourceListTags(conn, accountID, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Access Grants Location (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAccessGrantsLocationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("iam_role_arn") {
+		input := &s3control.UpdateAccessGrantsLocationInput{
+			AccessGrantsLocationId: aws.String(locationID),
+			AccountId:              aws.String(accountID),
+			IAMRoleArn:             aws.String(d.Get("iam_role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Access Grants Location: %s", input)
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+			return conn.UpdateAccessGrantsLocation(input)
+		}, errCodeInvalidIAMRole)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Access Grants Location (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := resourceUpdateTags(conn, accountID, d.Get("access_grants_location_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating S3 Access Grants Location (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAccessGrantsLocationRead(d, meta)
+}
+
+func resourceAccessGrantsLocationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, locationID, err := AccessGrantsLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Access Grants Location: %s", d.Id())
+	// Grants in the location may still be being deleted.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.DeleteAccessGrantsLocation(&s3control.DeleteAccessGrantsLocationInput{
+			AccessGrantsLocationId: aws.String(locationID),
+			AccountId:              aws.String(accountID),
+		})
+	}, errCodeAccessGrantsLocationNotEmptyError)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessGrantsInstanceNotExistsError, errCodeAccessGrantsLocationNotExistsError) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Access Grants Location (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const accessGrantsLocationResourceIDSeparator = ","
+
+func AccessGrantsLocationCreateResourceID(accountID, locationID string) string {
+	parts := []string{accountID, locationID}
+	id := strings.Join(parts, accessGrantsLocationResourceIDSeparator)
+
+	return id
+}
+
+func AccessGrantsLocationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, accessGrantsLocationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]saccess-grants-location-id", id, accessGrantsLocationResourceIDSeparator)
+}
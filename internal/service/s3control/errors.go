@@ -3,8 +3,14 @@ package s3control
 // Error code constants missing from AWS Go SDK:
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 const (
-	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
-	errCodeNoSuchAsyncRequest           = "NoSuchAsyncRequest"
-	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
+	errCodeAccessGrantNotExistsError                   = "AccessGrantNotExistsError"
+	errCodeAccessGrantsInstanceNotExistsError          = "AccessGrantsInstanceNotExistsError"
+	errCodeAccessGrantsInstanceResourcePolicyNotExists = "AccessGrantsInstanceResourcePolicyNotExists"
+	errCodeAccessGrantsLocationNotEmptyError           = "AccessGrantsLocationNotEmptyError"
+	errCodeAccessGrantsLocationNotExistsError          = "AccessGrantsLocationNotExistsError"
+	errCodeInvalidIAMRole                              = "InvalidIamRole"
+	errCodeNoSuchAccessPoint                           = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy                     = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAsyncRequest                          = "NoSuchAsyncRequest"
+	errCodeNoSuchMultiRegionAccessPoint                = "NoSuchMultiRegionAccessPoint"
 )
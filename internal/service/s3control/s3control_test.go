@@ -0,0 +1,43 @@
+package s3control_test
+
+import (
+	"testing"
+)
+
+// S3 Access Grants are limited to one instance per account per region, so run serially
+// locally and in TeamCity.
+func TestAccS3Control_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"AccessGrant": {
+			"basic":                 testAccAccessGrant_basic,
+			"disappears":            testAccAccessGrant_disappears,
+			"locationConfiguration": testAccAccessGrant_locationConfiguration,
+		},
+		"AccessGrantsInstance": {
+			"basic":      testAccAccessGrantsInstance_basic,
+			"disappears": testAccAccessGrantsInstance_disappears,
+			"tags":       testAccAccessGrantsInstance_tags,
+		},
+		"AccessGrantsInstanceResourcePolicy": {
+			"basic":      testAccAccessGrantsInstanceResourcePolicy_basic,
+			"disappears": testAccAccessGrantsInstanceResourcePolicy_disappears,
+		},
+		"AccessGrantsLocation": {
+			"basic":      testAccAccessGrantsLocation_basic,
+			"disappears": testAccAccessGrantsLocation_disappears,
+			"update":     testAccAccessGrantsLocation_update,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -92,3 +92,84 @@ func bucketUpdateTags(conn *s3control.S3Control, identifier string, oldTagsMap i
 
 	return nil
 }
+
+// resourceTags returns s3control service tags for resources tagged via TagResource.
+func resourceTags(tags tftags.KeyValueTags) []*s3control.Tag {
+	result := make([]*s3control.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &s3control.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// resourceKeyValueTags creates tftags.KeyValueTags from s3control service tags for resources tagged via TagResource.
+func resourceKeyValueTags(tags []*s3control.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(m)
+}
+
+// resourceListTags lists S3control resource tags.
+// The identifier is the resource ARN.
+func resourceListTags(conn *s3control.S3Control, accountID, identifier string) (tftags.KeyValueTags, error) {
+	input := &s3control.ListTagsForResourceInput{
+		AccountId:   aws.String(accountID),
+		ResourceArn: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResource(input)
+
+	if err != nil {
+		return tftags.New(nil), err
+	}
+
+	return resourceKeyValueTags(output.Tags), nil
+}
+
+// resourceUpdateTags updates S3control resource tags.
+// The identifier is the resource ARN.
+func resourceUpdateTags(conn *s3control.S3Control, accountID, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(oldTagsMap)
+	newTags := tftags.New(newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &s3control.UntagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(identifier),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}
+
+		_, err := conn.UntagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &s3control.TagResourceInput{
+			AccountId:   aws.String(accountID),
+			ResourceArn: aws.String(identifier),
+			Tags:        resourceTags(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResource(input)
+
+		if err != nil {
+			return fmt.Errorf("error tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
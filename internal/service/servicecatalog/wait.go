@@ -2,11 +2,13 @@ package servicecatalog
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -536,6 +538,36 @@ func WaitProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, accep
 	return err
 }
 
+func WaitRecordReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, id string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{StatusNotFound, servicecatalog.RecordStatusCreated, servicecatalog.RecordStatusInProgress, servicecatalog.RecordStatusInProgressInError},
+		Target:                    []string{servicecatalog.RecordStatusSucceeded},
+		Refresh:                   StatusRecord(conn, acceptLanguage, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: ContinuousTargetOccurrence,
+		NotFoundChecks:            NotFoundChecks,
+		MinTimeout:                MinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		if recordErrors := output.RecordDetail.RecordErrors; len(recordErrors) > 0 {
+			var errs *multierror.Error
+
+			for _, recordError := range recordErrors {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(recordError.Code), aws.StringValue(recordError.Description)))
+			}
+
+			tfresource.SetLastError(err, errs.ErrorOrNil())
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitPortfolioConstraintsReady(conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, productID string, timeout time.Duration) ([]*servicecatalog.ConstraintDetail, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{StatusNotFound},
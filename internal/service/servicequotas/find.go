@@ -96,3 +96,137 @@ func findServiceQuotaByID(conn *servicequotas.ServiceQuotas, serviceCode, quotaC
 
 	return output.Quota, nil
 }
+
+func FindTemplateByThreePartKey(conn *servicequotas.ServiceQuotas, region, quotaCode, serviceCode string) (*servicequotas.ServiceQuotaIncreaseRequestInTemplate, error) {
+	input := &servicequotas.ListServiceQuotaIncreaseRequestsInTemplateInput{
+		AwsRegion:   aws.String(region),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	var output *servicequotas.ServiceQuotaIncreaseRequestInTemplate
+	err := conn.ListServiceQuotaIncreaseRequestsInTemplatePages(input, func(page *servicequotas.ListServiceQuotaIncreaseRequestsInTemplateOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceQuotaIncreaseRequestInTemplateList {
+			if aws.StringValue(v.QuotaCode) == quotaCode {
+				output = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("Service Quota Template (%s/%s/%s) not found", region, serviceCode, quotaCode),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindTemplateAssociation(conn *servicequotas.ServiceQuotas) (*servicequotas.GetAssociationForServiceQuotaTemplateOutput, error) {
+	input := &servicequotas.GetAssociationForServiceQuotaTemplateInput{}
+
+	output, err := conn.GetAssociationForServiceQuotaTemplate(input)
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException, servicequotas.ErrCodeServiceQuotaTemplateNotInUseException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ServiceQuotaTemplateAssociationStatus); status == servicequotas.ServiceQuotaTemplateAssociationStatusDisassociated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findServiceQuotasDefaultByServiceCode(conn *servicequotas.ServiceQuotas, serviceCode string) ([]*servicequotas.ServiceQuota, error) {
+	input := &servicequotas.ListAWSDefaultServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	var output []*servicequotas.ServiceQuota
+	err := conn.ListAWSDefaultServiceQuotasPages(input, func(page *servicequotas.ListAWSDefaultServiceQuotasOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Quotas {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findServiceQuotasByServiceCode(conn *servicequotas.ServiceQuotas, serviceCode string) ([]*servicequotas.ServiceQuota, error) {
+	input := &servicequotas.ListServiceQuotasInput{
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	var output []*servicequotas.ServiceQuota
+	err := conn.ListServiceQuotasPages(input, func(page *servicequotas.ListServiceQuotasOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Quotas {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicequotas.ErrCodeNoSuchResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
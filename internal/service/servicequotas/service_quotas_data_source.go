@@ -0,0 +1,171 @@
+package servicequotas
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceServiceQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceServiceQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"adjustable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"global_quota": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"quota_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"quota_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage_metric": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_dimensions": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"metric_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"metric_namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"metric_statistic_recommendation": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"value": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceQuotasConn
+
+	serviceCode := d.Get("service_code").(string)
+
+	// Every quota has a default value, but only quotas that have been set have an applied value.
+	defaultQuotas, err := findServiceQuotasDefaultByServiceCode(conn, serviceCode)
+
+	if err != nil {
+		return fmt.Errorf("error listing Default Service Quotas for (%s): %w", serviceCode, err)
+	}
+
+	appliedQuotas, err := findServiceQuotasByServiceCode(conn, serviceCode)
+
+	if err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error listing Service Quotas for (%s): %w", serviceCode, err)
+	}
+
+	appliedQuotasByCode := make(map[string]*servicequotas.ServiceQuota, len(appliedQuotas))
+	for _, v := range appliedQuotas {
+		appliedQuotasByCode[aws.StringValue(v.QuotaCode)] = v
+	}
+
+	var serviceName string
+	quotas := make([]interface{}, 0, len(defaultQuotas))
+
+	for _, defaultQuota := range defaultQuotas {
+		serviceName = aws.StringValue(defaultQuota.ServiceName)
+
+		tfMap := flattenServiceQuota(defaultQuota)
+		tfMap["default_value"] = aws.Float64Value(defaultQuota.Value)
+
+		if v, ok := appliedQuotasByCode[aws.StringValue(defaultQuota.QuotaCode)]; ok && v.Value != nil && v.ErrorReason == nil {
+			tfMap["arn"] = aws.StringValue(v.QuotaArn)
+			tfMap["value"] = aws.Float64Value(v.Value)
+		}
+
+		quotas = append(quotas, tfMap)
+	}
+
+	d.SetId(serviceCode)
+	d.Set("service_code", serviceCode)
+	d.Set("service_name", serviceName)
+
+	if err := d.Set("quotas", quotas); err != nil {
+		return fmt.Errorf("error setting quotas: %w", err)
+	}
+
+	return nil
+}
+
+func flattenServiceQuota(apiObject *servicequotas.ServiceQuota) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"adjustable":   aws.BoolValue(apiObject.Adjustable),
+		"arn":          aws.StringValue(apiObject.QuotaArn),
+		"global_quota": aws.BoolValue(apiObject.GlobalQuota),
+		"quota_code":   aws.StringValue(apiObject.QuotaCode),
+		"quota_name":   aws.StringValue(apiObject.QuotaName),
+		"unit":         aws.StringValue(apiObject.Unit),
+		"value":        aws.Float64Value(apiObject.Value),
+	}
+
+	if v := apiObject.UsageMetric; v != nil {
+		tfMap["usage_metric"] = []interface{}{map[string]interface{}{
+			"metric_dimensions":               aws.StringValueMap(v.MetricDimensions),
+			"metric_name":                     aws.StringValue(v.MetricName),
+			"metric_namespace":                aws.StringValue(v.MetricNamespace),
+			"metric_statistic_recommendation": aws.StringValue(v.MetricStatisticRecommendation),
+		}}
+	}
+
+	return tfMap
+}
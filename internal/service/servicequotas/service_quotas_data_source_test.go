@@ -0,0 +1,48 @@
+package servicequotas_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccServiceQuotasServiceQuotasDataSource_basic(t *testing.T) {
+	const dataSourceName = "data.aws_servicequotas_service_quotas.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicequotas.EndpointsID, t)
+			preCheckServiceQuotaSet(setQuotaServiceCode, setQuotaQuotaCode, t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, servicequotas.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceQuotasDataSourceConfig(setQuotaServiceCode),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_code", setQuotaServiceCode),
+					resource.TestCheckResourceAttr(dataSourceName, "service_name", "Amazon Virtual Private Cloud (Amazon VPC)"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "quotas.*", map[string]string{
+						"adjustable":    "true",
+						"default_value": "5",
+						"global_quota":  "false",
+						"quota_code":    setQuotaQuotaCode,
+						"quota_name":    setQuotaQuotaName,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceQuotasDataSourceConfig(serviceCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quotas" "test" {
+  service_code = %[1]q
+}
+`, serviceCode)
+}
@@ -1,13 +1,15 @@
 package ses
 
-import (
+import ( // nosemgrep: aws-sdk-go-multiple-service-imports
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,6 +37,10 @@ func ResourceConfigurationSet() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"sending_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"tls_policy": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -64,12 +70,63 @@ func ResourceConfigurationSet() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"vdm_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dashboard_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"engagement_metrics": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"guardian_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"optimized_shared_delivery": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(sesv2.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
+	sesv2conn := meta.(*conns.AWSClient).SESV2Conn
 
 	configurationSetName := d.Get("name").(string)
 
@@ -87,12 +144,10 @@ func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(configurationSetName)
 
 	if v, ok := d.GetOk("delivery_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input := &ses.PutConfigurationSetDeliveryOptionsInput{
-			ConfigurationSetName: aws.String(configurationSetName),
-			DeliveryOptions:      expandConfigurationSetDeliveryOptions(v.([]interface{})),
-		}
+		input := expandConfigurationSetDeliveryOptions(v.([]interface{}))
+		input.ConfigurationSetName = aws.String(configurationSetName)
 
-		_, err := conn.PutConfigurationSetDeliveryOptions(input)
+		_, err := sesv2conn.PutConfigurationSetDeliveryOptions(input)
 		if err != nil {
 			return fmt.Errorf("error adding SES configuration set (%s) delivery options: %w", configurationSetName, err)
 		}
@@ -122,11 +177,36 @@ func resourceConfigurationSetCreate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if v, ok := d.GetOk("tracking_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(configurationSetName),
+			CustomRedirectDomain: expandConfigurationSetCustomRedirectDomain(v.([]interface{})),
+		}
+
+		_, err := sesv2conn.PutConfigurationSetTrackingOptions(input)
+		if err != nil {
+			return fmt.Errorf("error adding SES configuration set (%s) tracking options: %w", configurationSetName, err)
+		}
+	}
+
+	if v, ok := d.GetOk("vdm_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := &sesv2.PutConfigurationSetVdmOptionsInput{
+			ConfigurationSetName: aws.String(configurationSetName),
+			VdmOptions:           expandConfigurationSetVDMOptions(v.([]interface{})),
+		}
+
+		_, err := sesv2conn.PutConfigurationSetVdmOptions(input)
+		if err != nil {
+			return fmt.Errorf("error adding SES configuration set (%s) VDM options: %w", configurationSetName, err)
+		}
+	}
+
 	return resourceConfigurationSetRead(d, meta)
 }
 
 func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
+	sesv2conn := meta.(*conns.AWSClient).SESV2Conn
 
 	configSetInput := &ses.DescribeConfigurationSetInput{
 		ConfigurationSetName: aws.String(d.Id()),
@@ -146,10 +226,27 @@ func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := d.Set("delivery_options", flattenConfigurationSetDeliveryOptions(response.DeliveryOptions)); err != nil {
+	// The sending pool, tracking and Virtual Deliverability Manager options are only available via the SESv2 API.
+	output, err := sesv2conn.GetConfigurationSet(&sesv2.GetConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading SES configuration set (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("delivery_options", flattenConfigurationSetDeliveryOptions(response.DeliveryOptions, output.DeliveryOptions)); err != nil {
 		return fmt.Errorf("error setting delivery_options: %w", err)
 	}
 
+	if err := d.Set("tracking_options", flattenConfigurationSetTrackingOptions(output.TrackingOptions)); err != nil {
+		return fmt.Errorf("error setting tracking_options: %w", err)
+	}
+
+	if err := d.Set("vdm_options", flattenConfigurationSetVDMOptions(output.VdmOptions)); err != nil {
+		return fmt.Errorf("error setting vdm_options: %w", err)
+	}
+
 	d.Set("name", response.ConfigurationSet.Name)
 
 	repOpts := response.ReputationOptions
@@ -173,14 +270,13 @@ func resourceConfigurationSetRead(d *schema.ResourceData, meta interface{}) erro
 
 func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SESConn
+	sesv2conn := meta.(*conns.AWSClient).SESV2Conn
 
 	if d.HasChange("delivery_options") {
-		input := &ses.PutConfigurationSetDeliveryOptionsInput{
-			ConfigurationSetName: aws.String(d.Id()),
-			DeliveryOptions:      expandConfigurationSetDeliveryOptions(d.Get("delivery_options").([]interface{})),
-		}
+		input := expandConfigurationSetDeliveryOptions(d.Get("delivery_options").([]interface{}))
+		input.ConfigurationSetName = aws.String(d.Id())
 
-		_, err := conn.PutConfigurationSetDeliveryOptions(input)
+		_, err := sesv2conn.PutConfigurationSetDeliveryOptions(input)
 		if err != nil {
 			return fmt.Errorf("error updating SES configuration set (%s) delivery options: %w", d.Id(), err)
 		}
@@ -210,6 +306,30 @@ func resourceConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("tracking_options") {
+		input := &sesv2.PutConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			CustomRedirectDomain: expandConfigurationSetCustomRedirectDomain(d.Get("tracking_options").([]interface{})),
+		}
+
+		_, err := sesv2conn.PutConfigurationSetTrackingOptions(input)
+		if err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) tracking options: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vdm_options") {
+		input := &sesv2.PutConfigurationSetVdmOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			VdmOptions:           expandConfigurationSetVDMOptions(d.Get("vdm_options").([]interface{})),
+		}
+
+		_, err := sesv2conn.PutConfigurationSetVdmOptions(input)
+		if err != nil {
+			return fmt.Errorf("error updating SES configuration set (%s) VDM options: %w", d.Id(), err)
+		}
+	}
+
 	return resourceConfigurationSetRead(d, meta)
 }
 
@@ -230,7 +350,32 @@ func resourceConfigurationSetDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func expandConfigurationSetDeliveryOptions(l []interface{}) *ses.DeliveryOptions {
+// expandConfigurationSetDeliveryOptions returns SESv2 delivery options.
+// The TLS policy is configured using the SES (v1) values, which differ from the SESv2 values only by case.
+func expandConfigurationSetDeliveryOptions(l []interface{}) *sesv2.PutConfigurationSetDeliveryOptionsInput {
+	input := &sesv2.PutConfigurationSetDeliveryOptionsInput{}
+
+	if len(l) == 0 || l[0] == nil {
+		return input
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	if v, ok := tfMap["sending_pool_name"].(string); ok && v != "" {
+		input.SendingPoolName = aws.String(v)
+	}
+
+	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
+		input.TlsPolicy = aws.String(strings.ToUpper(v))
+	}
+
+	return input
+}
+
+func expandConfigurationSetCustomRedirectDomain(l []interface{}) *string {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -240,16 +385,45 @@ func expandConfigurationSetDeliveryOptions(l []interface{}) *ses.DeliveryOptions
 		return nil
 	}
 
-	options := &ses.DeliveryOptions{}
+	if v, ok := tfMap["custom_redirect_domain"].(string); ok && v != "" {
+		return aws.String(v)
+	}
 
-	if v, ok := tfMap["tls_policy"].(string); ok && v != "" {
-		options.TlsPolicy = aws.String(v)
+	return nil
+}
+
+func expandConfigurationSetVDMOptions(l []interface{}) *sesv2.VdmOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	options := &sesv2.VdmOptions{}
+
+	if v, ok := tfMap["dashboard_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options.DashboardOptions = &sesv2.DashboardOptions{}
+
+		if v, ok := v[0].(map[string]interface{})["engagement_metrics"].(string); ok && v != "" {
+			options.DashboardOptions.EngagementMetrics = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["guardian_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		options.GuardianOptions = &sesv2.GuardianOptions{}
+
+		if v, ok := v[0].(map[string]interface{})["optimized_shared_delivery"].(string); ok && v != "" {
+			options.GuardianOptions.OptimizedSharedDelivery = aws.String(v)
+		}
 	}
 
 	return options
 }
 
-func flattenConfigurationSetDeliveryOptions(options *ses.DeliveryOptions) []interface{} {
+func flattenConfigurationSetDeliveryOptions(options *ses.DeliveryOptions, v2Options *sesv2.DeliveryOptions) []interface{} {
 	if options == nil {
 		return nil
 	}
@@ -258,5 +432,43 @@ func flattenConfigurationSetDeliveryOptions(options *ses.DeliveryOptions) []inte
 		"tls_policy": aws.StringValue(options.TlsPolicy),
 	}
 
+	if v2Options != nil {
+		m["sending_pool_name"] = aws.StringValue(v2Options.SendingPoolName)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenConfigurationSetTrackingOptions(options *sesv2.TrackingOptions) []interface{} {
+	if options == nil || aws.StringValue(options.CustomRedirectDomain) == "" {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"custom_redirect_domain": aws.StringValue(options.CustomRedirectDomain),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenConfigurationSetVDMOptions(options *sesv2.VdmOptions) []interface{} {
+	if options == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+
+	if v := options.DashboardOptions; v != nil {
+		m["dashboard_options"] = []interface{}{map[string]interface{}{
+			"engagement_metrics": aws.StringValue(v.EngagementMetrics),
+		}}
+	}
+
+	if v := options.GuardianOptions; v != nil {
+		m["guardian_options"] = []interface{}{map[string]interface{}{
+			"optimized_shared_delivery": aws.StringValue(v.OptimizedSharedDelivery),
+		}}
+	}
+
 	return []interface{}{m}
 }
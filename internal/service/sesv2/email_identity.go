@@ -0,0 +1,360 @@
+package sesv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEmailIdentityCreate,
+		ReadWithoutTimeout:   resourceEmailIdentityRead,
+		UpdateWithoutTimeout: resourceEmailIdentityUpdate,
+		DeleteWithoutTimeout: resourceEmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_signing_key_length": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_signing_private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(1, 20480),
+							ConflictsWith: []string{
+								"dkim_signing_attributes.0.next_signing_key_length",
+							},
+							RequiredWith: []string{
+								"dkim_signing_attributes.0.domain_signing_selector",
+							},
+						},
+						"domain_signing_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+							ConflictsWith: []string{
+								"dkim_signing_attributes.0.next_signing_key_length",
+							},
+							RequiredWith: []string{
+								"dkim_signing_attributes.0.domain_signing_private_key",
+							},
+						},
+						"last_key_generation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_signing_key_length": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sesv2.DkimSigningKeyLength_Values(), false),
+							ConflictsWith: []string{
+								"dkim_signing_attributes.0.domain_signing_private_key",
+								"dkim_signing_attributes.0.domain_signing_selector",
+							},
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEmailIdentityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.CreateEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration_set_name"); ok {
+		input.ConfigurationSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DkimSigningAttributes = expandDKIMSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating SESv2 Email Identity: %s", name)
+	_, err := conn.CreateEmailIdentityWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Email Identity (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEmailIdentityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("identity/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+	d.Set("email_identity", d.Id())
+	d.Set("identity_type", output.IdentityType)
+	d.Set("verified_for_sending_status", output.VerifiedForSendingStatus)
+
+	if output.DkimAttributes != nil {
+		tfMap := flattenDKIMAttributes(output.DkimAttributes)
+
+		// The private key and selector are never returned by the API.
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMapOld := v.([]interface{})[0].(map[string]interface{})
+			tfMap["domain_signing_private_key"] = tfMapOld["domain_signing_private_key"]
+			tfMap["domain_signing_selector"] = tfMapOld["domain_signing_selector"]
+		}
+
+		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
+			return diag.Errorf("setting dkim_signing_attributes: %s", err)
+		}
+	} else {
+		d.Set("dkim_signing_attributes", nil)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	if d.HasChange("configuration_set_name") {
+		input := &sesv2.PutEmailIdentityConfigurationSetAttributesInput{
+			EmailIdentity: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration_set_name"); ok {
+			input.ConfigurationSetName = aws.String(v.(string))
+		}
+
+		_, err := conn.PutEmailIdentityConfigurationSetAttributesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) configuration set: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector", "dkim_signing_attributes.0.next_signing_key_length") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: aws.String(sesv2.DkimSigningAttributesOriginAwsSes),
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+				input.SigningAttributesOrigin = aws.String(sesv2.DkimSigningAttributesOriginExternal)
+			}
+
+			input.SigningAttributes = expandDKIMSigningAttributes(tfMap)
+		}
+
+		_, err := conn.PutEmailIdentityDkimSigningAttributesWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) DKIM signing attributes: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating SESv2 Email Identity (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceEmailIdentityRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Email Identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentityWithContext(ctx, &sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Email Identity (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindEmailIdentityByID(ctx context.Context, conn *sesv2.SESV2, id string) (*sesv2.GetEmailIdentityOutput, error) {
+	input := &sesv2.GetEmailIdentityInput{
+		EmailIdentity: aws.String(id),
+	}
+
+	output, err := conn.GetEmailIdentityWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDKIMSigningAttributes(tfMap map[string]interface{}) *sesv2.DkimSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DkimSigningAttributes{}
+
+	if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+		apiObject.DomainSigningPrivateKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["domain_signing_selector"].(string); ok && v != "" {
+		apiObject.DomainSigningSelector = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" {
+		apiObject.NextSigningKeyLength = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDKIMAttributes(apiObject *sesv2.DkimAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"current_signing_key_length": aws.StringValue(apiObject.CurrentSigningKeyLength),
+		"next_signing_key_length":    aws.StringValue(apiObject.NextSigningKeyLength),
+		"signing_attributes_origin":  aws.StringValue(apiObject.SigningAttributesOrigin),
+		"status":                     aws.StringValue(apiObject.Status),
+		"tokens":                     aws.StringValueSlice(apiObject.Tokens),
+	}
+
+	if v := apiObject.LastKeyGenerationTimestamp; v != nil {
+		tfMap["last_key_generation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,116 @@
+package sesv2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEmailIdentityFeedbackAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEmailIdentityFeedbackAttributesCreate,
+		ReadWithoutTimeout:   resourceEmailIdentityFeedbackAttributesRead,
+		UpdateWithoutTimeout: resourceEmailIdentityFeedbackAttributesUpdate,
+		DeleteWithoutTimeout: resourceEmailIdentityFeedbackAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email_forwarding_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEmailIdentityFeedbackAttributesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(d.Get("email_forwarding_enabled").(bool)),
+		EmailIdentity:          aws.String(name),
+	}
+
+	_, err := conn.PutEmailIdentityFeedbackAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Email Identity (%s) feedback attributes: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityFeedbackAttributesRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityFeedbackAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	output, err := FindEmailIdentityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) feedback attributes not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Email Identity (%s) feedback attributes: %s", d.Id(), err)
+	}
+
+	d.Set("email_forwarding_enabled", output.FeedbackForwardingStatus)
+	d.Set("email_identity", d.Id())
+
+	return nil
+}
+
+func resourceEmailIdentityFeedbackAttributesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	input := &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailForwardingEnabled: aws.Bool(d.Get("email_forwarding_enabled").(bool)),
+		EmailIdentity:          aws.String(d.Id()),
+	}
+
+	_, err := conn.PutEmailIdentityFeedbackAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating SESv2 Email Identity (%s) feedback attributes: %s", d.Id(), err)
+	}
+
+	return resourceEmailIdentityFeedbackAttributesRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityFeedbackAttributesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Email Identity (%s) feedback attributes", d.Id())
+	_, err := conn.PutEmailIdentityFeedbackAttributesWithContext(ctx, &sesv2.PutEmailIdentityFeedbackAttributesInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Email Identity (%s) feedback attributes: %s", d.Id(), err)
+	}
+
+	return nil
+}
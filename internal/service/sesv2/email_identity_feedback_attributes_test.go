@@ -0,0 +1,90 @@
+package sesv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestAccSESV2EmailIdentityFeedbackAttributes_basic(t *testing.T) {
+	email := acctest.DefaultEmailAddress
+	resourceName := "aws_sesv2_email_identity_feedback_attributes.test"
+	emailIdentityResourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sesv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig_basic(email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesForwarding(resourceName, true),
+					resource.TestCheckResourceAttrPair(resourceName, "email_identity", emailIdentityResourceName, "email_identity"),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityFeedbackAttributesConfig_basic(email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityFeedbackAttributesForwarding(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "email_forwarding_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityFeedbackAttributesForwarding(n string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindEmailIdentityByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.FeedbackForwardingStatus); got != enabled {
+			return fmt.Errorf("SESv2 Email Identity (%s) feedback forwarding status: got %t, want %t", rs.Primary.ID, got, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIdentityFeedbackAttributesConfig_basic(email string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+
+resource "aws_sesv2_email_identity_feedback_attributes" "test" {
+  email_identity           = aws_sesv2_email_identity.test.email_identity
+  email_forwarding_enabled = %[2]t
+}
+`, email, enabled)
+}
@@ -0,0 +1,137 @@
+package sesv2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEmailIdentityMailFromAttributes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEmailIdentityMailFromAttributesCreate,
+		ReadWithoutTimeout:   resourceEmailIdentityMailFromAttributesRead,
+		UpdateWithoutTimeout: resourceEmailIdentityMailFromAttributesUpdate,
+		DeleteWithoutTimeout: resourceEmailIdentityMailFromAttributesDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"behavior_on_mx_failure": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sesv2.BehaviorOnMxFailureUseDefaultValue,
+				ValidateFunc: validation.StringInSlice(sesv2.BehaviorOnMxFailure_Values(), false),
+			},
+			"email_identity": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mail_from_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceEmailIdentityMailFromAttributesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	name := d.Get("email_identity").(string)
+	input := expandEmailIdentityMailFromAttributes(d)
+	input.EmailIdentity = aws.String(name)
+
+	_, err := conn.PutEmailIdentityMailFromAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating SESv2 Email Identity (%s) MAIL FROM attributes: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityMailFromAttributesRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityMailFromAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	output, err := FindEmailIdentityByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) MAIL FROM attributes not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading SESv2 Email Identity (%s) MAIL FROM attributes: %s", d.Id(), err)
+	}
+
+	d.Set("email_identity", d.Id())
+
+	if v := output.MailFromAttributes; v != nil {
+		d.Set("behavior_on_mx_failure", v.BehaviorOnMxFailure)
+		d.Set("mail_from_domain", v.MailFromDomain)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityMailFromAttributesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	input := expandEmailIdentityMailFromAttributes(d)
+	input.EmailIdentity = aws.String(d.Id())
+
+	_, err := conn.PutEmailIdentityMailFromAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating SESv2 Email Identity (%s) MAIL FROM attributes: %s", d.Id(), err)
+	}
+
+	return resourceEmailIdentityMailFromAttributesRead(ctx, d, meta)
+}
+
+func resourceEmailIdentityMailFromAttributesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SESV2Conn
+
+	log.Printf("[INFO] Deleting SESv2 Email Identity (%s) MAIL FROM attributes", d.Id())
+	_, err := conn.PutEmailIdentityMailFromAttributesWithContext(ctx, &sesv2.PutEmailIdentityMailFromAttributesInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting SESv2 Email Identity (%s) MAIL FROM attributes: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandEmailIdentityMailFromAttributes(d *schema.ResourceData) *sesv2.PutEmailIdentityMailFromAttributesInput {
+	input := &sesv2.PutEmailIdentityMailFromAttributesInput{}
+
+	if v, ok := d.GetOk("behavior_on_mx_failure"); ok {
+		input.BehaviorOnMxFailure = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mail_from_domain"); ok {
+		input.MailFromDomain = aws.String(v.(string))
+	}
+
+	return input
+}
@@ -0,0 +1,99 @@
+package sesv2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+)
+
+func TestAccSESV2EmailIdentityMailFromAttributes_basic(t *testing.T) {
+	domain := acctest.RandomDomain()
+	mailFromDomain1 := domain.RandomSubdomain().String()
+	mailFromDomain2 := domain.RandomSubdomain().String()
+	resourceName := "aws_sesv2_email_identity_mail_from_attributes.test"
+	emailIdentityResourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sesv2.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityMailFromAttributesConfig_basic(domain.String(), sesv2.BehaviorOnMxFailureUseDefaultValue, mailFromDomain1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityMailFromAttributesDomain(resourceName, mailFromDomain1),
+					resource.TestCheckResourceAttrPair(resourceName, "email_identity", emailIdentityResourceName, "email_identity"),
+					resource.TestCheckResourceAttr(resourceName, "behavior_on_mx_failure", sesv2.BehaviorOnMxFailureUseDefaultValue),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityMailFromAttributesConfig_basic(domain.String(), sesv2.BehaviorOnMxFailureRejectMessage, mailFromDomain2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityMailFromAttributesDomain(resourceName, mailFromDomain2),
+					resource.TestCheckResourceAttr(resourceName, "behavior_on_mx_failure", sesv2.BehaviorOnMxFailureRejectMessage),
+					resource.TestCheckResourceAttr(resourceName, "mail_from_domain", mailFromDomain2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityMailFromAttributesDomain(n, mailFromDomain string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn
+
+		output, err := tfsesv2.FindEmailIdentityByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output.MailFromAttributes == nil {
+			return fmt.Errorf("SESv2 Email Identity (%s) MAIL FROM attributes not found", rs.Primary.ID)
+		}
+
+		if got := aws.StringValue(output.MailFromAttributes.MailFromDomain); got != mailFromDomain {
+			return fmt.Errorf("SESv2 Email Identity (%s) MAIL FROM domain: got %s, want %s", rs.Primary.ID, got, mailFromDomain)
+		}
+
+		return nil
+	}
+}
+
+func testAccEmailIdentityMailFromAttributesConfig_basic(domain, behaviorOnMxFailure, mailFromDomain string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+
+resource "aws_sesv2_email_identity_mail_from_attributes" "test" {
+  email_identity         = aws_sesv2_email_identity.test.email_identity
+  behavior_on_mx_failure = %[2]q
+  mail_from_domain       = %[3]q
+}
+`, domain, behaviorOnMxFailure, mailFromDomain)
+}
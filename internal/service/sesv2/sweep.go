@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sesv2_dedicated_ip_pool", &resource.Sweeper{
+		Name: "aws_sesv2_dedicated_ip_pool",
+		F:    sweepDedicatedIPPools,
+	})
+}
+
+func sweepDedicatedIPPools(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SESV2Conn
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DedicatedIpPools {
+			r := ResourceDedicatedIPPool()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping SESv2 Dedicated IP Pool sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing SESv2 Dedicated IP Pools (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping SESv2 Dedicated IP Pools (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package sfn
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliasCreate,
+		Read:   resourceAliasRead,
+		Update: resourceAliasUpdate,
+		Delete: resourceAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validStateMachineName,
+			},
+
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SFNConn
+
+	name := d.Get("name").(string)
+	input := &sfn.CreateStateMachineAliasInput{
+		Name:                 aws.String(name),
+		RoutingConfiguration: expandRoutingConfigurationListItems(d.Get("routing_configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Step Function State Machine Alias: %s", input)
+	output, err := conn.CreateStateMachineAlias(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Step Function State Machine Alias (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.StateMachineAliasArn))
+
+	return resourceAliasRead(d, meta)
+}
+
+func resourceAliasRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SFNConn
+
+	output, err := FindAliasByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Step Function State Machine Alias (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Step Function State Machine Alias (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.StateMachineAliasArn)
+	if output.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	} else {
+		d.Set("creation_date", nil)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	if err := d.Set("routing_configuration", flattenRoutingConfigurationListItems(output.RoutingConfiguration)); err != nil {
+		return fmt.Errorf("error setting routing_configuration: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SFNConn
+
+	input := &sfn.UpdateStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("routing_configuration") {
+		input.RoutingConfiguration = expandRoutingConfigurationListItems(d.Get("routing_configuration").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Step Function State Machine Alias: %s", input)
+	_, err := conn.UpdateStateMachineAlias(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Step Function State Machine Alias (%s): %w", d.Id(), err)
+	}
+
+	return resourceAliasRead(d, meta)
+}
+
+func resourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SFNConn
+
+	log.Printf("[DEBUG] Deleting Step Function State Machine Alias: %s", d.Id())
+	_, err := conn.DeleteStateMachineAlias(&sfn.DeleteStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Step Function State Machine Alias (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRoutingConfigurationListItems(tfList []interface{}) []*sfn.RoutingConfigurationListItem {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*sfn.RoutingConfigurationListItem
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &sfn.RoutingConfigurationListItem{}
+
+		if v, ok := tfMap["state_machine_version_arn"].(string); ok && v != "" {
+			apiObject.StateMachineVersionArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["weight"].(int); ok {
+			apiObject.Weight = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingConfigurationListItems(apiObjects []*sfn.RoutingConfigurationListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"state_machine_version_arn": aws.StringValue(apiObject.StateMachineVersionArn),
+			"weight":                    aws.Int64Value(apiObject.Weight),
+		})
+	}
+
+	return tfList
+}
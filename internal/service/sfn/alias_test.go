@@ -0,0 +1,193 @@
+package sfn_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSFNAlias_basic(t *testing.T) {
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &alias),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "states", fmt.Sprintf("stateMachine:%[1]s:%[1]s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAliasConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNAlias_disappears(t *testing.T) {
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &alias),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsfn.ResourceAlias(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSFNAlias_routingConfiguration(t *testing.T) {
+	var alias sfn.DescribeStateMachineAliasOutput
+	resourceName := "aws_sfn_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_basic(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "1"),
+				),
+			},
+			{
+				Config: testAccAliasConfig_routingConfiguration(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "20"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.1.weight", "80"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAliasExists(n string, v *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Step Function State Machine Alias ID set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn
+
+		output, err := tfsfn.FindAliasByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAliasDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SFNConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sfn_alias" {
+			continue
+		}
+
+		_, err := tfsfn.FindAliasByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Step Function State Machine Alias %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAliasConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_publish(rName, 5), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = 100
+  }
+}
+`, rName, description))
+}
+
+func testAccAliasConfig_routingConfiguration(rName string, weight int) string {
+	// Changing the definition publishes version 2 of the state machine; traffic is split with version 1.
+	return acctest.ConfigCompose(testAccStateMachineConfig_publish(rName, 10), fmt.Sprintf(`
+resource "aws_sfn_alias" "test" {
+  name        = %[1]q
+  description = "test"
+
+  routing_configuration {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    weight                    = %[2]d
+  }
+
+  routing_configuration {
+    state_machine_version_arn = "${aws_sfn_state_machine.test.arn}:1"
+    weight                    = %[3]d
+  }
+}
+`, rName, weight, 100-weight))
+}
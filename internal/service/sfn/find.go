@@ -34,3 +34,62 @@ func FindStateMachineByARN(conn *sfn.SFN, arn string) (*sfn.DescribeStateMachine
 
 	return output, nil
 }
+
+// FindLatestStateMachineVersionByARN returns the most recently published version of the specified state machine.
+func FindLatestStateMachineVersionByARN(conn *sfn.SFN, arn string) (*sfn.StateMachineVersionListItem, error) {
+	input := &sfn.ListStateMachineVersionsInput{
+		StateMachineArn: aws.String(arn),
+		// Versions are returned in descending order of creation.
+		MaxResults: aws.Int64(1),
+	}
+
+	output, err := conn.ListStateMachineVersions(input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeStateMachineDoesNotExist) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.StateMachineVersions) == 0 || output.StateMachineVersions[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.StateMachineVersions[0], nil
+}
+
+func FindAliasByARN(conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineAliasOutput, error) {
+	input := &sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeStateMachineAlias(input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
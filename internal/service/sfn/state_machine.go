@@ -1,13 +1,17 @@
 package sfn
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,7 +28,10 @@ func ResourceStateMachine() *schema.Resource {
 		Update: resourceStateMachineUpdate,
 		Delete: resourceStateMachineDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("publish", false)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,6 +51,33 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
 
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_data_key_reuse_period_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 900),
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(sfn.EncryptionType_Values(), false),
+						},
+					},
+				},
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+			},
+
 			"logging_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -76,12 +110,28 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validStateMachineName,
 			},
 
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -113,9 +163,21 @@ func ResourceStateMachine() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"publish"},
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceStateMachineLoggingCustomizeDiff,
+			customdiff.ComputedIf("state_machine_version_arn", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("publish").(bool) && diff.HasChanges("definition", "encryption_configuration", "logging_configuration", "publish", "role_arn", "tracing_configuration")
+			}),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -133,14 +195,26 @@ func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error
 		Type:       aws.String(d.Get("type").(string)),
 	}
 
+	if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.EncryptionConfiguration = expandEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.LoggingConfiguration = expandLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("publish"); ok {
+		input.Publish = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("tracing_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.TracingConfiguration = expandTracingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("version_description"); ok && aws.BoolValue(input.Publish) {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
 	var output *sfn.CreateStateMachineOutput
 
 	log.Printf("[DEBUG] Creating Step Function State Machine: %s", input)
@@ -177,6 +251,7 @@ func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.SetId(aws.StringValue(output.StateMachineArn))
+	d.Set("state_machine_version_arn", output.StateMachineVersionArn)
 
 	return resourceStateMachineRead(d, meta)
 }
@@ -205,11 +280,35 @@ func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("creation_date", nil)
 	}
 	d.Set("definition", output.Definition)
+
+	if output.EncryptionConfiguration != nil {
+		if err := d.Set("encryption_configuration", []interface{}{flattenEncryptionConfiguration(output.EncryptionConfiguration)}); err != nil {
+			return fmt.Errorf("error setting encryption_configuration: %w", err)
+		}
+	} else {
+		d.Set("encryption_configuration", nil)
+	}
+
 	d.Set("name", output.Name)
+	d.Set("revision_id", output.RevisionId)
 	d.Set("role_arn", output.RoleArn)
 	d.Set("type", output.Type)
 	d.Set("status", output.Status)
 
+	version, err := FindLatestStateMachineVersionByARN(conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("state_machine_version_arn", nil)
+	case tfawserr.ErrCodeEquals(err, "AccessDeniedException"):
+		// Without states:ListStateMachineVersions the version ARN returned by Create and Update is kept.
+		log.Printf("[WARN] Unable to list Step Function State Machine (%s) versions: %s", d.Id(), err)
+	case err != nil:
+		return fmt.Errorf("error reading Step Function State Machine (%s) versions: %w", d.Id(), err)
+	default:
+		d.Set("state_machine_version_arn", version.StateMachineVersionArn)
+	}
+
 	if output.LoggingConfiguration != nil {
 		if err := d.Set("logging_configuration", []interface{}{flattenLoggingConfiguration(output.LoggingConfiguration)}); err != nil {
 			return fmt.Errorf("error setting logging_configuration: %w", err)
@@ -261,6 +360,12 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 			RoleArn:         aws.String(d.Get("role_arn").(string)),
 		}
 
+		if d.HasChange("encryption_configuration") {
+			if v, ok := d.GetOk("encryption_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.EncryptionConfiguration = expandEncryptionConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("logging_configuration") {
 			if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.LoggingConfiguration = expandLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
@@ -273,13 +378,26 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
+		if v, ok := d.GetOk("publish"); ok {
+			input.Publish = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("version_description"); ok && aws.BoolValue(input.Publish) {
+			input.VersionDescription = aws.String(v.(string))
+		}
+
 		log.Printf("[DEBUG] Updating Step Function State Machine: %s", input)
-		_, err := conn.UpdateStateMachine(input)
+		output, err := conn.UpdateStateMachine(input)
 
 		if err != nil {
 			return fmt.Errorf("error updating Step Function State Machine (%s): %w", d.Id(), err)
 		}
 
+		// Kept for when Read cannot list the state machine's versions.
+		if v := output.StateMachineVersionArn; v != nil {
+			d.Set("state_machine_version_arn", v)
+		}
+
 		// Handle eventual consistency after update.
 		err = resource.Retry(stateMachineUpdatedTimeout, func() *resource.RetryError {
 			output, err := FindStateMachineByARN(conn, d.Id())
@@ -292,7 +410,9 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 				d.HasChange("role_arn") && aws.StringValue(output.RoleArn) != d.Get("role_arn").(string) ||
 				d.HasChange("tracing_configuration.0.enabled") && output.TracingConfiguration != nil && aws.BoolValue(output.TracingConfiguration.Enabled) != d.Get("tracing_configuration.0.enabled").(bool) ||
 				d.HasChange("logging_configuration.0.include_execution_data") && output.LoggingConfiguration != nil && aws.BoolValue(output.LoggingConfiguration.IncludeExecutionData) != d.Get("logging_configuration.0.include_execution_data").(bool) ||
-				d.HasChange("logging_configuration.0.level") && output.LoggingConfiguration != nil && aws.StringValue(output.LoggingConfiguration.Level) != d.Get("logging_configuration.0.level").(string) {
+				d.HasChange("logging_configuration.0.level") && output.LoggingConfiguration != nil && aws.StringValue(output.LoggingConfiguration.Level) != d.Get("logging_configuration.0.level").(string) ||
+				d.HasChange("logging_configuration.0.log_destination") && output.LoggingConfiguration != nil && len(output.LoggingConfiguration.Destinations) > 0 && output.LoggingConfiguration.Destinations[0].CloudWatchLogsLogGroup != nil && aws.StringValue(output.LoggingConfiguration.Destinations[0].CloudWatchLogsLogGroup.LogGroupArn) != d.Get("logging_configuration.0.log_destination").(string) ||
+				d.HasChange("encryption_configuration.0.type") && output.EncryptionConfiguration != nil && aws.StringValue(output.EncryptionConfiguration.Type) != d.Get("encryption_configuration.0.type").(string) {
 				return resource.RetryableError(fmt.Errorf("Step Function State Machine (%s) eventual consistency", d.Id()))
 			}
 
@@ -332,6 +452,81 @@ func resourceStateMachineDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// resourceStateMachineLoggingCustomizeDiff validates the logging level and log destination combination.
+// A CloudWatch Logs log group destination is required for any logging level other than OFF, for both
+// STANDARD and EXPRESS state machines, and the destination must be a log group ARN ending in ":*".
+func resourceStateMachineLoggingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("logging_configuration")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if !diff.NewValueKnown("logging_configuration.0.log_destination") {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	level, _ := tfMap["level"].(string)
+	logDestination, _ := tfMap["log_destination"].(string)
+
+	if level != "" && level != sfn.LogLevelOff && logDestination == "" {
+		return fmt.Errorf("logging_configuration.0.log_destination must be set when logging level is %s for %s state machines", level, diff.Get("type").(string))
+	}
+
+	if logDestination != "" {
+		if v, err := arn.Parse(logDestination); err != nil || v.Service != "logs" || !strings.HasPrefix(v.Resource, "log-group:") || !strings.HasSuffix(v.Resource, ":*") {
+			return fmt.Errorf("logging_configuration.0.log_destination (%s) must be a CloudWatch Logs log group ARN ending in \":*\"", logDestination)
+		}
+	}
+
+	return nil
+}
+
+func expandEncryptionConfiguration(tfMap map[string]interface{}) *sfn.EncryptionConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sfn.EncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_data_key_reuse_period_seconds"].(int); ok && v != 0 {
+		apiObject.KmsDataKeyReusePeriodSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenEncryptionConfiguration(apiObject *sfn.EncryptionConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsDataKeyReusePeriodSeconds; v != nil {
+		tfMap["kms_data_key_reuse_period_seconds"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func expandLoggingConfiguration(tfMap map[string]interface{}) *sfn.LoggingConfiguration {
 	if tfMap == nil {
 		return nil
@@ -373,7 +568,7 @@ func flattenLoggingConfiguration(apiObject *sfn.LoggingConfiguration) map[string
 		tfMap["level"] = aws.StringValue(v)
 	}
 
-	if v := apiObject.Destinations; len(v) > 0 {
+	if v := apiObject.Destinations; len(v) > 0 && v[0] != nil && v[0].CloudWatchLogsLogGroup != nil {
 		tfMap["log_destination"] = aws.StringValue(v[0].CloudWatchLogsLogGroup.LogGroupArn)
 	}
 
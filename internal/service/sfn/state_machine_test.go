@@ -303,6 +303,147 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_expressLoggingAndTracingUpdate(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_expressLoggingAndTracing(rName, sfn.LogLevelError, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "type", sfn.StateMachineTypeExpress),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelError),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStateMachineConfig_expressLoggingAndTracing(rName, sfn.LogLevelAll, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", sfn.LogLevelAll),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_loggingLevelWithoutDestination(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_loggingNoDestination(rName),
+				ExpectError: regexp.MustCompile(`logging_configuration.0.log_destination must be set`),
+			},
+			{
+				Config:      testAccStateMachineConfig_loggingInvalidDestination(rName),
+				ExpectError: regexp.MustCompile(`must be a CloudWatch Logs log group ARN ending in ":\*"`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_encryptionConfiguration(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_encryptionConfigurationAWSOwnedKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", sfn.EncryptionTypeAwsOwnedKey),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStateMachineConfig_encryptionConfigurationCustomerManagedKMSKey(rName, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.type", sfn.EncryptionTypeCustomerManagedKmsKey),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_configuration.0.kms_data_key_reuse_period_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_publish(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_publish(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "test"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "version_description"},
+			},
+			{
+				Config: testAccStateMachineConfig_publish(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`.*\"MaxAttempts\": 10.*`)),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -709,3 +850,212 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_expressLoggingAndTracing(rName, rLevel string, includeExecutionData, tracingEnabled bool) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    log_destination        = "${aws_cloudwatch_log_group.test.arn}:*"
+    include_execution_data = %[3]t
+    level                  = %[2]q
+  }
+
+  tracing_configuration {
+    enabled = %[4]t
+  }
+}
+`, rName, rLevel, includeExecutionData, tracingEnabled))
+}
+
+func testAccStateMachineConfig_loggingNoDestination(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    level = "ERROR"
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineConfig_loggingInvalidDestination(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = "EXPRESS"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  logging_configuration {
+    level           = "ERROR"
+    log_destination = "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:%[1]s"
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineConfig_encryptionConfigurationAWSOwnedKey(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  encryption_configuration {
+    type = "AWS_OWNED_KEY"
+  }
+}
+`, rName))
+}
+
+func testAccStateMachineConfig_encryptionConfigurationCustomerManagedKMSKey(rName string, reusePeriodSeconds int) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role_policy" "for_sfn_kms" {
+  name = "%[1]s-sfn-kms"
+  role = aws_iam_role.for_sfn.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["kms:Decrypt", "kms:GenerateDataKey"]
+      Resource = aws_kms_key.test.arn
+    }]
+  })
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "End": true
+    }
+  }
+}
+EOF
+
+  encryption_configuration {
+    kms_key_id                        = aws_kms_key.test.arn
+    type                              = "CUSTOMER_MANAGED_KMS_KEY"
+    kms_data_key_reuse_period_seconds = %[2]d
+  }
+
+  depends_on = [aws_iam_role_policy.for_sfn_kms]
+}
+`, rName, reusePeriodSeconds))
+}
+
+func testAccStateMachineConfig_publish(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                = %[1]q
+  role_arn            = aws_iam_role.for_sfn.arn
+  publish             = true
+  version_description = "test"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[2]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, rMaxAttempts))
+}
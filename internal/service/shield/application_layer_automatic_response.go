@@ -0,0 +1,240 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	applicationLayerAutomaticResponseActionBlock = "BLOCK"
+	applicationLayerAutomaticResponseActionCount = "COUNT"
+)
+
+func applicationLayerAutomaticResponseAction_Values() []string {
+	return []string{
+		applicationLayerAutomaticResponseActionBlock,
+		applicationLayerAutomaticResponseActionCount,
+	}
+}
+
+func ResourceApplicationLayerAutomaticResponse() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationLayerAutomaticResponseCreate,
+		Read:   resourceApplicationLayerAutomaticResponseRead,
+		Update: resourceApplicationLayerAutomaticResponseUpdate,
+		Delete: resourceApplicationLayerAutomaticResponseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(applicationLayerAutomaticResponseAction_Values(), false),
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceApplicationLayerAutomaticResponseCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	resourceARN := d.Get("resource_arn").(string)
+	input := &shield.EnableApplicationLayerAutomaticResponseInput{
+		Action:      expandResponseAction(d.Get("action").(string)),
+		ResourceArn: aws.String(resourceARN),
+	}
+
+	_, err := conn.EnableApplicationLayerAutomaticResponse(input)
+
+	if err != nil {
+		return fmt.Errorf("error enabling Shield Application Layer Automatic Response (%s): %w", resourceARN, err)
+	}
+
+	d.SetId(resourceARN)
+
+	if _, err := waitApplicationLayerAutomaticResponseEnabled(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Shield Application Layer Automatic Response (%s) enable: %w", d.Id(), err)
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	output, err := FindApplicationLayerAutomaticResponseByResourceARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Application Layer Automatic Response (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+	}
+
+	d.Set("action", flattenResponseAction(output.Action))
+	d.Set("resource_arn", d.Id())
+
+	return nil
+}
+
+func resourceApplicationLayerAutomaticResponseUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("action") {
+		input := &shield.UpdateApplicationLayerAutomaticResponseInput{
+			Action:      expandResponseAction(d.Get("action").(string)),
+			ResourceArn: aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateApplicationLayerAutomaticResponse(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitApplicationLayerAutomaticResponseEnabled(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Shield Application Layer Automatic Response (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationLayerAutomaticResponseRead(d, meta)
+}
+
+func resourceApplicationLayerAutomaticResponseDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Disabling Shield Application Layer Automatic Response: %s", d.Id())
+	_, err := conn.DisableApplicationLayerAutomaticResponse(&shield.DisableApplicationLayerAutomaticResponseInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if tfawserr.ErrMessageContains(err, shield.ErrCodeInvalidOperationException, "not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disabling Shield Application Layer Automatic Response (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitApplicationLayerAutomaticResponseDisabled(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Shield Application Layer Automatic Response (%s) disable: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func statusApplicationLayerAutomaticResponse(conn *shield.Shield, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProtectionByResourceARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output.ApplicationLayerAutomaticResponseConfiguration == nil {
+			return output, shield.ApplicationLayerAutomaticResponseStatusDisabled, nil
+		}
+
+		return output, aws.StringValue(output.ApplicationLayerAutomaticResponseConfiguration.Status), nil
+	}
+}
+
+func waitApplicationLayerAutomaticResponseEnabled(conn *shield.Shield, arn string, timeout time.Duration) (*shield.Protection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{shield.ApplicationLayerAutomaticResponseStatusDisabled},
+		Target:                    []string{shield.ApplicationLayerAutomaticResponseStatusEnabled},
+		Refresh:                   statusApplicationLayerAutomaticResponse(conn, arn),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*shield.Protection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitApplicationLayerAutomaticResponseDisabled(conn *shield.Shield, arn string, timeout time.Duration) (*shield.Protection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{shield.ApplicationLayerAutomaticResponseStatusEnabled},
+		Target:  []string{shield.ApplicationLayerAutomaticResponseStatusDisabled},
+		Refresh: statusApplicationLayerAutomaticResponse(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*shield.Protection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandResponseAction(action string) *shield.ResponseAction {
+	apiObject := &shield.ResponseAction{}
+
+	switch action {
+	case applicationLayerAutomaticResponseActionBlock:
+		apiObject.Block = &shield.BlockAction{}
+	case applicationLayerAutomaticResponseActionCount:
+		apiObject.Count = &shield.CountAction{}
+	}
+
+	return apiObject
+}
+
+func flattenResponseAction(apiObject *shield.ResponseAction) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	if apiObject.Block != nil {
+		return applicationLayerAutomaticResponseActionBlock
+	}
+
+	if apiObject.Count != nil {
+		return applicationLayerAutomaticResponseActionCount
+	}
+
+	return ""
+}
@@ -0,0 +1,7 @@
+package shield
+
+import "time"
+
+const (
+	propagationTimeout = 2 * time.Minute
+)
@@ -0,0 +1,106 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDRTAccessLogBucketAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDRTAccessLogBucketAssociationCreate,
+		Read:   resourceDRTAccessLogBucketAssociationRead,
+		Delete: resourceDRTAccessLogBucketAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"role_arn_association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDRTAccessLogBucketAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	logBucket := d.Get("log_bucket").(string)
+	input := &shield.AssociateDRTLogBucketInput{
+		LogBucket: aws.String(logBucket),
+	}
+
+	// Retry for bucket policy and DRT role eventual consistency.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateDRTLogBucket(input)
+	}, shield.ErrCodeAccessDeniedForDependencyException, shield.ErrCodeNoAssociatedRoleException)
+
+	if err != nil {
+		return fmt.Errorf("error associating Shield DRT Log Bucket (%s): %w", logBucket, err)
+	}
+
+	d.SetId(logBucket)
+
+	return resourceDRTAccessLogBucketAssociationRead(d, meta)
+}
+
+func resourceDRTAccessLogBucketAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	logBucket, err := FindDRTLogBucketAssociation(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield DRT Log Bucket Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Log Bucket Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("log_bucket", logBucket)
+
+	if _, ok := d.GetOk("role_arn_association_id"); !ok {
+		if output, err := FindDRTAccess(conn); err == nil {
+			d.Set("role_arn_association_id", output.RoleArn)
+		}
+	}
+
+	return nil
+}
+
+func resourceDRTAccessLogBucketAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Deleting Shield DRT Log Bucket Association: %s", d.Id())
+	_, err := conn.DisassociateDRTLogBucket(&shield.DisassociateDRTLogBucketInput{
+		LogBucket: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Shield DRT Log Bucket (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
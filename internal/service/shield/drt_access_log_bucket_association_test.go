@@ -0,0 +1,102 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccShieldDRTAccessLogBucketAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_drt_access_log_bucket_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, shield.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckDRTAccessLogBucketAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDRTAccessLogBucketAssociationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDRTAccessLogBucketAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "log_bucket", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn_association_id", "aws_shield_drt_access_role_arn_association.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDRTAccessLogBucketAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_drt_access_log_bucket_association" {
+			continue
+		}
+
+		_, err := tfshield.FindDRTLogBucketAssociation(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Shield DRT Log Bucket Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDRTAccessLogBucketAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield DRT Log Bucket Association ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindDRTLogBucketAssociation(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDRTAccessLogBucketAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDRTAccessRoleARNAssociationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_shield_drt_access_log_bucket_association" "test" {
+  log_bucket              = aws_s3_bucket.test.id
+  role_arn_association_id = aws_shield_drt_access_role_arn_association.test.id
+}
+`, rName))
+}
@@ -0,0 +1,93 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDRTAccessRoleARNAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDRTAccessRoleARNAssociationCreate,
+		Read:   resourceDRTAccessRoleARNAssociationRead,
+		Delete: resourceDRTAccessRoleARNAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDRTAccessRoleARNAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	roleARN := d.Get("role_arn").(string)
+	input := &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleARN),
+	}
+
+	// Retry for IAM eventual consistency.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(propagationTimeout, func() (interface{}, error) {
+		return conn.AssociateDRTRole(input)
+	}, shield.ErrCodeInvalidParameterException, shield.ErrCodeAccessDeniedForDependencyException)
+
+	if err != nil {
+		return fmt.Errorf("error associating Shield DRT Role (%s): %w", roleARN, err)
+	}
+
+	d.SetId(roleARN)
+
+	return resourceDRTAccessRoleARNAssociationRead(d, meta)
+}
+
+func resourceDRTAccessRoleARNAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	roleARN, err := FindDRTRoleARNAssociation(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield DRT Role Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Role Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("role_arn", roleARN)
+
+	return nil
+}
+
+func resourceDRTAccessRoleARNAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	log.Printf("[DEBUG] Deleting Shield DRT Role Association: %s", d.Id())
+	_, err := conn.DisassociateDRTRole(&shield.DisassociateDRTRoleInput{})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Shield DRT Role (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package shield
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindProtectionByResourceARN(conn *shield.Shield, arn string) (*shield.Protection, error) {
+	input := &shield.DescribeProtectionInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeProtection(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Protection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Protection, nil
+}
+
+func FindApplicationLayerAutomaticResponseByResourceARN(conn *shield.Shield, arn string) (*shield.ApplicationLayerAutomaticResponseConfiguration, error) {
+	protection, err := FindProtectionByResourceARN(conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := protection.ApplicationLayerAutomaticResponseConfiguration
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(arn)
+	}
+
+	if status := aws.StringValue(output.Status); status == shield.ApplicationLayerAutomaticResponseStatusDisabled {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: arn,
+		}
+	}
+
+	return output, nil
+}
+
+func FindSubscription(conn *shield.Shield) (*shield.Subscription, error) {
+	input := &shield.DescribeSubscriptionInput{}
+
+	output, err := conn.DescribeSubscription(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscription, nil
+}
+
+func FindDRTAccess(conn *shield.Shield) (*shield.DescribeDRTAccessOutput, error) {
+	input := &shield.DescribeDRTAccessInput{}
+
+	output, err := conn.DescribeDRTAccess(input)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindDRTRoleARNAssociation(conn *shield.Shield, roleARN string) (*string, error) {
+	output, err := FindDRTAccess(conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.StringValue(output.RoleArn) != roleARN {
+		return nil, &resource.NotFoundError{
+			LastRequest: roleARN,
+		}
+	}
+
+	return output.RoleArn, nil
+}
+
+func FindDRTLogBucketAssociation(conn *shield.Shield, bucket string) (*string, error) {
+	output, err := FindDRTAccess(conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.LogBucketList {
+		if aws.StringValue(v) == bucket {
+			return v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: bucket,
+	}
+}
@@ -0,0 +1,108 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSubscriptionCreate,
+		Read:   resourceSubscriptionRead,
+		Update: resourceSubscriptionUpdate,
+		Delete: resourceSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_renew": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      shield.AutoRenewEnabled,
+				ValidateFunc: validation.StringInSlice(shield.AutoRenew_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	_, err := conn.CreateSubscription(&shield.CreateSubscriptionInput{})
+
+	// The subscription is an account-level singleton, adopt an existing one.
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceAlreadyExistsException) {
+		log.Printf("[INFO] Shield Advanced subscription already exists, adopting it")
+		err = nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Shield Subscription: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+
+	if err := updateSubscription(conn, d.Get("auto_renew").(string)); err != nil {
+		return fmt.Errorf("error updating Shield Subscription (%s): %w", d.Id(), err)
+	}
+
+	return resourceSubscriptionRead(d, meta)
+}
+
+func resourceSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	output, err := FindSubscription(conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Shield Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription (%s): %w", d.Id(), err)
+	}
+
+	d.Set("auto_renew", output.AutoRenew)
+
+	return nil
+}
+
+func resourceSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn
+
+	if d.HasChange("auto_renew") {
+		if err := updateSubscription(conn, d.Get("auto_renew").(string)); err != nil {
+			return fmt.Errorf("error updating Shield Subscription (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceSubscriptionRead(d, meta)
+}
+
+func resourceSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	// Shield Advanced subscriptions carry a one year commitment and cannot be cancelled via the API.
+	log.Printf("[WARN] Shield Subscription (%s) cannot be deleted, removing from state only. Disable auto_renew to end the subscription at the end of its term.", d.Id())
+
+	return nil
+}
+
+func updateSubscription(conn *shield.Shield, autoRenew string) error {
+	input := &shield.UpdateSubscriptionInput{
+		AutoRenew: aws.String(autoRenew),
+	}
+
+	_, err := conn.UpdateSubscription(input)
+
+	return err
+}
@@ -0,0 +1,81 @@
+package shield_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+func TestAccShieldSubscription_basic(t *testing.T) {
+	// Subscribing to Shield Advanced incurs a one year commitment.
+	if os.Getenv("SHIELD_SUBSCRIPTION_ACCEPT_COMMITMENT") == "" {
+		t.Skip("Environment variable SHIELD_SUBSCRIPTION_ACCEPT_COMMITMENT is not set")
+	}
+
+	resourceName := "aws_shield_subscription.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, shield.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSubscriptionConfig_basic(shield.AutoRenewEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", shield.AutoRenewEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Shield Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn
+
+		_, err := tfshield.FindSubscription(conn)
+
+		return err
+	}
+}
+
+func testAccSubscriptionConfig_basic(autoRenew string) string {
+	return fmt.Sprintf(`
+resource "aws_shield_subscription" "test" {
+  auto_renew = %[1]q
+}
+`, autoRenew)
+}
@@ -5,3 +5,32 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	platformIDAmazonFreeRTOSDefault             = "AmazonFreeRTOS-Default"
+	platformIDAmazonFreeRTOSTICC3220SF          = "AmazonFreeRTOS-TI-CC3220SF"
+	platformIDAWSIoTDeviceManagementSHA256ECDSA = "AWSIoTDeviceManagement-SHA256-ECDSA"
+	platformIDAWSLambdaSHA384ECDSA              = "AWSLambda-SHA384-ECDSA"
+	platformIDNotationOCISHA384ECDSA            = "Notation-OCI-SHA384-ECDSA"
+)
+
+func platformID_Values() []string {
+	return []string{
+		platformIDAmazonFreeRTOSDefault,
+		platformIDAmazonFreeRTOSTICC3220SF,
+		platformIDAWSIoTDeviceManagementSHA256ECDSA,
+		platformIDAWSLambdaSHA384ECDSA,
+		platformIDNotationOCISHA384ECDSA,
+	}
+}
+
+// platformRequiresSigningMaterial returns whether the signing platform signs
+// with a customer-supplied ACM certificate rather than AWS-managed material.
+func platformRequiresSigningMaterial(platformID string) bool {
+	switch platformID {
+	case platformIDAmazonFreeRTOSDefault, platformIDAmazonFreeRTOSTICC3220SF, platformIDAWSIoTDeviceManagementSHA256ECDSA:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/signer"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -31,12 +34,10 @@ func ResourceSigningProfile() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"platform_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"AWSLambda-SHA384-ECDSA"},
-					false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(platformID_Values(), false),
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -75,6 +76,29 @@ func ResourceSigningProfile() *schema.Resource {
 					},
 				},
 			},
+			"signing_material": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"signing_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"arn": {
@@ -119,7 +143,10 @@ func ResourceSigningProfile() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceSigningProfileCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -146,6 +173,14 @@ func resourceSigningProfileCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("signing_material"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		signingProfileInput.SigningMaterial = expandSigningMaterial(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("signing_parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		signingProfileInput.SigningParameters = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		signingProfileInput.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -183,15 +218,18 @@ func resourceSigningProfileRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting signer signing profile platform id: %s", err)
 	}
 
-	if err := d.Set("signature_validity_period", []interface{}{
-		map[string]interface{}{
-			"value": signingProfileOutput.SignatureValidityPeriod.Value,
-			"type":  signingProfileOutput.SignatureValidityPeriod.Type,
-		},
-	}); err != nil {
+	if err := d.Set("signature_validity_period", flattenSignatureValidityPeriod(signingProfileOutput.SignatureValidityPeriod)); err != nil {
 		return fmt.Errorf("error setting signer signing profile signature validity period: %s", err)
 	}
 
+	if err := d.Set("signing_material", flattenSigningMaterial(signingProfileOutput.SigningMaterial)); err != nil {
+		return fmt.Errorf("error setting signer signing profile signing material: %s", err)
+	}
+
+	if err := d.Set("signing_parameters", aws.StringValueMap(signingProfileOutput.SigningParameters)); err != nil {
+		return fmt.Errorf("error setting signer signing profile signing parameters: %s", err)
+	}
+
 	if err := d.Set("platform_display_name", signingProfileOutput.PlatformDisplayName); err != nil {
 		return fmt.Errorf("error setting signer signing profile platform display name: %s", err)
 	}
@@ -267,6 +305,59 @@ func resourceSigningProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceSigningProfileCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	platformID := d.Get("platform_id").(string)
+
+	if platformID == "" {
+		return nil
+	}
+
+	if platformRequiresSigningMaterial(platformID) && len(d.Get("signing_material").([]interface{})) == 0 {
+		return fmt.Errorf("signing_material is required for platform_id %q", platformID)
+	}
+
+	return nil
+}
+
+func expandSigningMaterial(tfMap map[string]interface{}) *signer.SigningMaterial {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &signer.SigningMaterial{}
+
+	if v, ok := tfMap["certificate_arn"].(string); ok && v != "" {
+		apiObject.CertificateArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSignatureValidityPeriod(apiObject *signer.SignatureValidityPeriod) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"type":  aws.StringValue(apiObject.Type),
+		"value": aws.Int64Value(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSigningMaterial(apiObject *signer.SigningMaterial) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"certificate_arn": aws.StringValue(apiObject.CertificateArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenSigningProfileRevocationRecord(apiObject *signer.SigningProfileRevocationRecord) interface{} {
 	if apiObject == nil {
 		return []interface{}{}
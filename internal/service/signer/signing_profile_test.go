@@ -155,6 +155,21 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_signingMaterialRequired(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, signer.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckSigningProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSigningProfileConfig_platformID("AWSIoTDeviceManagement-SHA256-ECDSA"),
+				ExpectError: regexp.MustCompile(`signing_material is required for platform_id`),
+			},
+		},
+	})
+}
+
 func testAccPreCheckSingerSigningProfile(t *testing.T, platformID string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerConn
 
@@ -245,6 +260,14 @@ resource "aws_signer_signing_profile" "test_sp" {
 `
 }
 
+func testAccSigningProfileConfig_platformID(platformID string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = %[1]q
+}
+`, platformID)
+}
+
 func testAccSigningProfileConfig_updateTags() string {
 	return `
 resource "aws_signer_signing_profile" "test_sp" {
@@ -149,6 +149,53 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"sync_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
+			},
+			"target_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -210,6 +257,14 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	resp, err := conn.CreateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error creating SSM association: %w", err)
@@ -266,6 +321,7 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("max_concurrency", association.MaxConcurrency)
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("sync_compliance", association.SyncCompliance)
 
 	if err := d.Set("parameters", flattenParameters(association.Parameters)); err != nil {
 		return err
@@ -279,6 +335,10 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting output_location error: %w", err)
 	}
 
+	if err := d.Set("target_location", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("Error setting target_location error: %w", err)
+	}
+
 	return nil
 }
 
@@ -336,6 +396,22 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if d.HasChange("target_location") {
+		// An empty list is sent to remove all target locations, as omitting
+		// TargetLocations leaves the existing value unchanged.
+		associationInput.TargetLocations = []*ssm.TargetLocation{}
+
+		if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+			associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+		}
+	} else if v, ok := d.GetOk("target_location"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	_, err := conn.UpdateAssociation(associationInput)
 	if err != nil {
 		return fmt.Errorf("Error updating SSM association: %s", err)
@@ -556,6 +556,73 @@ func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_syncCompliance(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_syncCompliance(rName, ssm.AssociationSyncComplianceManual),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "compliance_severity", ssm.ComplianceSeverityHigh),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceManual),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationConfig_syncCompliance(rName, ssm.AssociationSyncComplianceAuto),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceAuto),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMAssociation_targetLocation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationConfig_targetLocation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.accounts.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_location.0.accounts.0", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.regions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_location.0.regions.0", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.target_location_max_concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_location.0.target_location_max_errors", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters"},
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_rateControl(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
@@ -1512,6 +1579,118 @@ resource "aws_ssm_association" "test" {
 `, rName, assocName, compSeverity)
 }
 
+func testAccAssociationConfig_syncCompliance(rName, syncCompliance string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name                = aws_ssm_document.test.name
+  compliance_severity = "HIGH"
+  sync_compliance     = %[2]q
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, syncCompliance)
+}
+
+func testAccAssociationConfig_targetLocation(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ssm.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = <<DOC
+{
+  "description": "Systems Manager Automation Demo",
+  "schemaVersion": "0.3",
+  "assumeRole": "{{ AutomationAssumeRole }}",
+  "parameters": {
+    "AutomationAssumeRole": {
+      "type": "String"
+    }
+  },
+  "mainSteps": [
+    {
+      "name": "sleep",
+      "action": "aws:sleep",
+      "inputs": {
+        "Duration": "PT1S"
+      }
+    }
+  ]
+}
+DOC
+}
+
+resource "aws_ssm_association" "test" {
+  name = aws_ssm_document.test.name
+
+  parameters = {
+    AutomationAssumeRole = aws_iam_role.test.arn
+  }
+
+  target_location {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    regions                         = [data.aws_region.current.name]
+    target_location_max_concurrency = "1"
+    target_location_max_errors      = "1"
+  }
+}
+`, rName)
+}
+
 func testAccAssociationConfig_rateControl(rName, rate string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
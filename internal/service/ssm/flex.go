@@ -55,3 +55,71 @@ func flattenTargets(targets []*ssm.Target) []map[string]interface{} {
 
 	return result
 }
+
+func expandTargetLocations(tfList []interface{}) []*ssm.TargetLocation {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.TargetLocation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.TargetLocation{}
+
+		if v, ok := tfMap["accounts"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Accounts = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["execution_role_name"].(string); ok && v != "" {
+			apiObject.ExecutionRoleName = aws.String(v)
+		}
+
+		if v, ok := tfMap["regions"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Regions = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["target_location_max_concurrency"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_location_max_errors"].(string); ok && v != "" {
+			apiObject.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTargetLocations(apiObjects []*ssm.TargetLocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"accounts":                        aws.StringValueSlice(apiObject.Accounts),
+			"execution_role_name":             aws.StringValue(apiObject.ExecutionRoleName),
+			"regions":                         aws.StringValueSlice(apiObject.Regions),
+			"target_location_max_concurrency": aws.StringValue(apiObject.TargetLocationMaxConcurrency),
+			"target_location_max_errors":      aws.StringValue(apiObject.TargetLocationMaxErrors),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
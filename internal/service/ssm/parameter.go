@@ -22,6 +22,9 @@ import (
 const (
 	// Maximum amount of time to wait for asynchronous validation on SSM Parameter creation.
 	parameterCreationValidationTimeout = 2 * time.Minute
+
+	// Maximum size of a parameter value in the standard-parameter tier.
+	parameterStandardTierMaxValueLength = 4 * 1024
 )
 
 func ResourceParameter() *schema.Resource {
@@ -49,7 +52,7 @@ func ResourceParameter() *schema.Resource {
 			"tier": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      ssm.ParameterTierStandard,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(ssm.ParameterTier_Values(), false),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return d.Get("tier").(string) == ssm.ParameterTierIntelligentTiering
@@ -109,6 +112,11 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && (new.(string) == ssm.ParameterTierStandard || new.(string) == ssm.ParameterTierIntelligentTiering)
 			}),
+			// When no tier is configured, a value that no longer fits the standard-parameter
+			// tier is automatically upgraded to the advanced-parameter tier.
+			customdiff.ComputedIf("tier", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("value") && diff.GetRawConfig().GetAttr("tier").IsNull() && len(diff.Get("value").(string)) > parameterStandardTierMaxValueLength
+			}),
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("value")
 			}),
@@ -127,7 +135,7 @@ func resourceParameterCreate(d *schema.ResourceData, meta interface{}) error {
 	paramInput := &ssm.PutParameterInput{
 		Name:           aws.String(name),
 		Type:           aws.String(d.Get("type").(string)),
-		Tier:           aws.String(d.Get("tier").(string)),
+		Tier:           aws.String(parameterTier(d)),
 		Value:          aws.String(d.Get("value").(string)),
 		Overwrite:      aws.Bool(ShouldUpdateParameter(d)),
 		AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
@@ -285,7 +293,7 @@ func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
 		paramInput := &ssm.PutParameterInput{
 			Name:           aws.String(d.Get("name").(string)),
 			Type:           aws.String(d.Get("type").(string)),
-			Tier:           aws.String(d.Get("tier").(string)),
+			Tier:           aws.String(parameterTier(d)),
 			Value:          aws.String(d.Get("value").(string)),
 			Overwrite:      aws.Bool(ShouldUpdateParameter(d)),
 			AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
@@ -344,6 +352,26 @@ func resourceParameterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// parameterTier returns the tier to use when putting the parameter.
+// If no tier is configured, the tier is chosen based on the size of the value:
+// values too large for the standard-parameter tier use the advanced-parameter tier.
+// A parameter is never downgraded from the advanced-parameter tier as the API does not allow it.
+func parameterTier(d *schema.ResourceData) string {
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("tier").IsNull() {
+		return d.Get("tier").(string)
+	}
+
+	if len(d.Get("value").(string)) > parameterStandardTierMaxValueLength {
+		return ssm.ParameterTierAdvanced
+	}
+
+	if v, ok := d.GetOk("tier"); ok {
+		return v.(string)
+	}
+
+	return ssm.ParameterTierStandard
+}
+
 func ShouldUpdateParameter(d *schema.ResourceData) bool {
 	// If the user has specified a preference, return their preference
 	if value, ok := d.GetOkExists("overwrite"); ok {
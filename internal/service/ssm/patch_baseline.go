@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -214,10 +216,27 @@ func ResourcePatchBaseline() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePatchBaselineSourceCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+// resourcePatchBaselineSourceCustomizeDiff ensures that patch sources are only configured for Linux operating systems.
+func resourcePatchBaselineSourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("source"); !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	switch os := d.Get("operating_system").(string); os {
+	case ssm.OperatingSystemMacos, ssm.OperatingSystemWindows:
+		return fmt.Errorf("source is only supported for Linux operating systems, not %s", os)
+	}
+
+	return nil
+}
+
 func resourcePatchBaselineCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SSMConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
@@ -279,6 +279,23 @@ func TestAccSSMPatchBaseline_sources(t *testing.T) {
 	})
 }
 
+func TestAccSSMPatchBaseline_sourcesWindows(t *testing.T) {
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckPatchBaselineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPatchBaselineConfig_sourceOperatingSystem(name, ssm.OperatingSystemWindows),
+				ExpectError: regexp.MustCompile(`source is only supported for Linux operating systems`),
+			},
+		},
+	})
+}
+
 func TestAccSSMPatchBaseline_approvedPatchesNonSec(t *testing.T) {
 	var ssmPatch ssm.PatchBaselineIdentity
 	name := sdkacctest.RandString(10)
@@ -600,6 +617,22 @@ resource "aws_ssm_patch_baseline" "test" {
 `, rName)
 }
 
+func testAccPatchBaselineConfig_sourceOperatingSystem(rName, operatingSystem string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  approved_patches = ["test123"]
+  operating_system = %[2]q
+
+  source {
+    name          = "My-Repo"
+    configuration = "[main]\nname=main\nenabled=1"
+    products      = ["AmazonLinux2018.03"]
+  }
+}
+`, rName, operatingSystem)
+}
+
 func testAccPatchBaselineConfig_sourceUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_patch_baseline" "test" {
@@ -0,0 +1,328 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationCreate,
+		Read:   resourceApplicationRead,
+		Update: resourceApplicationUpdate,
+		Delete: resourceApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"application_account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"application_provider_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"portal_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sign_in_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+									},
+									"origin": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ssoadmin.SignInOrigin_Values(), false),
+									},
+								},
+							},
+						},
+						"visibility": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.ApplicationVisibility_Values(), false),
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.ApplicationStatus_Values(), false),
+			},
+
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &ssoadmin.CreateApplicationInput{
+		ApplicationProviderArn: aws.String(d.Get("application_provider_arn").(string)),
+		ClientToken:            aws.String(resource.UniqueId()),
+		InstanceArn:            aws.String(d.Get("instance_arn").(string)),
+		Name:                   aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PortalOptions = expandPortalOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		input.Status = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateApplication(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Application (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ApplicationArn))
+
+	return resourceApplicationRead(d, meta)
+}
+
+func resourceApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindApplicationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Application (%s): %w", d.Id(), err)
+	}
+
+	instanceArn := aws.StringValue(output.InstanceArn)
+
+	d.Set("application_account", output.ApplicationAccount)
+	d.Set("application_provider_arn", output.ApplicationProviderArn)
+	d.Set("arn", output.ApplicationArn)
+	d.Set("description", output.Description)
+	d.Set("instance_arn", instanceArn)
+	d.Set("name", output.Name)
+	if err := d.Set("portal_options", flattenPortalOptions(output.PortalOptions)); err != nil {
+		return fmt.Errorf("error setting portal_options: %w", err)
+	}
+	d.Set("status", output.Status)
+
+	tags, err := ListTags(conn, d.Id(), instanceArn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SSO Application (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	if d.HasChanges("description", "name", "portal_options", "status") {
+		input := &ssoadmin.UpdateApplicationInput{
+			ApplicationArn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("portal_options") {
+			if v, ok := d.GetOk("portal_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				tfMap := v.([]interface{})[0].(map[string]interface{})
+				input.PortalOptions = &ssoadmin.UpdateApplicationPortalOptions{}
+
+				if v, ok := tfMap["sign_in_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					input.PortalOptions.SignInOptions = expandSignInOptions(v[0].(map[string]interface{}))
+				}
+			}
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		_, err := conn.UpdateApplication(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SSO Application (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), d.Get("instance_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SSO Application (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceApplicationRead(d, meta)
+}
+
+func resourceApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	log.Printf("[DEBUG] Deleting SSO Application: %s", d.Id())
+	_, err := conn.DeleteApplication(&ssoadmin.DeleteApplicationInput{
+		ApplicationArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Application (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPortalOptions(tfMap map[string]interface{}) *ssoadmin.PortalOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.PortalOptions{}
+
+	if v, ok := tfMap["sign_in_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SignInOptions = expandSignInOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["visibility"].(string); ok && v != "" {
+		apiObject.Visibility = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSignInOptions(tfMap map[string]interface{}) *ssoadmin.SignInOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ssoadmin.SignInOptions{}
+
+	if v, ok := tfMap["application_url"].(string); ok && v != "" {
+		apiObject.ApplicationUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["origin"].(string); ok && v != "" {
+		apiObject.Origin = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPortalOptions(apiObject *ssoadmin.PortalOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"visibility": aws.StringValue(apiObject.Visibility),
+	}
+
+	if v := apiObject.SignInOptions; v != nil {
+		tfMap["sign_in_options"] = []interface{}{map[string]interface{}{
+			"application_url": aws.StringValue(v.ApplicationUrl),
+			"origin":          aws.StringValue(v.Origin),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,154 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationAssignmentCreate,
+		Read:   resourceApplicationAssignmentRead,
+		Delete: resourceApplicationAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 47),
+					validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+				),
+			},
+
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceApplicationAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationArn := d.Get("application_arn").(string)
+	principalID := d.Get("principal_id").(string)
+	principalType := d.Get("principal_type").(string)
+	id := ApplicationAssignmentCreateResourceID(applicationArn, principalID, principalType)
+
+	input := &ssoadmin.CreateApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationArn),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	}
+
+	_, err := conn.CreateApplicationAssignment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Application Assignment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceApplicationAssignmentRead(d, meta)
+}
+
+func resourceApplicationAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationArn, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindApplicationAssignmentByThreePartKey(conn, applicationArn, principalID, principalType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Assignment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Application Assignment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_arn", output.ApplicationArn)
+	d.Set("principal_id", output.PrincipalId)
+	d.Set("principal_type", output.PrincipalType)
+
+	return nil
+}
+
+func resourceApplicationAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationArn, principalID, principalType, err := ApplicationAssignmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Application Assignment: %s", d.Id())
+	_, err = conn.DeleteApplicationAssignment(&ssoadmin.DeleteApplicationAssignmentInput{
+		ApplicationArn: aws.String(applicationArn),
+		PrincipalId:    aws.String(principalID),
+		PrincipalType:  aws.String(principalType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Application Assignment (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const applicationAssignmentResourceIDSeparator = ","
+
+func ApplicationAssignmentCreateResourceID(applicationArn, principalID, principalType string) string {
+	parts := []string{applicationArn, principalID, principalType}
+	id := strings.Join(parts, applicationAssignmentResourceIDSeparator)
+
+	return id
+}
+
+func ApplicationAssignmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, applicationAssignmentResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION_ARN%[2]sPRINCIPAL_ID%[2]sPRINCIPAL_TYPE", id, applicationAssignmentResourceIDSeparator)
+}
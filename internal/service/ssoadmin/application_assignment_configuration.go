@@ -0,0 +1,103 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceApplicationAssignmentConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApplicationAssignmentConfigurationPut,
+		Read:   resourceApplicationAssignmentConfigurationRead,
+		Update: resourceApplicationAssignmentConfigurationPut,
+		Delete: resourceApplicationAssignmentConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"assignment_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceApplicationAssignmentConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	applicationArn := d.Get("application_arn").(string)
+	input := &ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(applicationArn),
+		AssignmentRequired: aws.Bool(d.Get("assignment_required").(bool)),
+	}
+
+	_, err := conn.PutApplicationAssignmentConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting SSO Application Assignment Configuration (%s): %w", applicationArn, err)
+	}
+
+	d.SetId(applicationArn)
+
+	return resourceApplicationAssignmentConfigurationRead(d, meta)
+}
+
+func resourceApplicationAssignmentConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	output, err := FindApplicationAssignmentConfigurationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Application Assignment Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Application Assignment Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_arn", d.Id())
+	d.Set("assignment_required", output.AssignmentRequired)
+
+	return nil
+}
+
+func resourceApplicationAssignmentConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	// There is no delete operation; restore the service default of requiring assignments.
+	log.Printf("[DEBUG] Deleting SSO Application Assignment Configuration: %s", d.Id())
+	_, err := conn.PutApplicationAssignmentConfiguration(&ssoadmin.PutApplicationAssignmentConfigurationInput{
+		ApplicationArn:     aws.String(d.Id()),
+		AssignmentRequired: aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Application Assignment Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
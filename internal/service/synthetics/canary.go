@@ -171,7 +171,8 @@ func ResourceCanary() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								return new == "rate(0 minute)" && old == "rate(0 hour)"
+								// The API normalizes run-once expressions to "rate(0 hour)".
+								return isRunOnceScheduleExpression(old) && isRunOnceScheduleExpression(new)
 							},
 						},
 					},
@@ -693,6 +694,12 @@ func flattenCanarySchedule(canarySchedule *synthetics.CanaryScheduleOutput) []in
 	return []interface{}{m}
 }
 
+var runOnceScheduleExpressionRegexp = regexp.MustCompile(`^rate\(0 (minute|minutes|hour|hours)\)$`)
+
+func isRunOnceScheduleExpression(expression string) bool {
+	return runOnceScheduleExpressionRegexp.MatchString(expression)
+}
+
 func expandCanaryRunConfig(l []interface{}) *synthetics.CanaryRunConfigInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -32,3 +32,74 @@ func FindCanaryByName(conn *synthetics.Synthetics, name string) (*synthetics.Can
 
 	return output.Canary, nil
 }
+
+func FindGroupByName(conn *synthetics.Synthetics, name string) (*synthetics.Group, error) {
+	input := &synthetics.GetGroupInput{
+		GroupIdentifier: aws.String(name),
+	}
+
+	output, err := conn.GetGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Group == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Group, nil
+}
+
+func FindGroupAssociationByTwoPartKey(conn *synthetics.Synthetics, canaryARN, groupName string) (*synthetics.Group, error) {
+	group, err := FindGroupByName(conn, groupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &synthetics.ListGroupResourcesInput{
+		GroupIdentifier: aws.String(groupName),
+	}
+
+	for {
+		output, err := conn.ListGroupResources(input)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.Resources {
+			if aws.StringValue(v) == canaryARN {
+				return group, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,147 @@
+package synthetics
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupAssociationCreate,
+		Read:   resourceGroupAssociationRead,
+		Delete: resourceGroupAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"canary_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SyntheticsConn
+
+	canaryARN := d.Get("canary_arn").(string)
+	groupName := d.Get("group_name").(string)
+	id := GroupAssociationCreateResourceID(canaryARN, groupName)
+	input := &synthetics.AssociateResourceInput{
+		GroupIdentifier: aws.String(groupName),
+		ResourceArn:     aws.String(canaryARN),
+	}
+
+	log.Printf("[DEBUG] Creating Synthetics Group Association: %s", input)
+	_, err := conn.AssociateResource(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Synthetics Group Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceGroupAssociationRead(d, meta)
+}
+
+func resourceGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SyntheticsConn
+
+	canaryARN, groupName, err := GroupAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	group, err := FindGroupAssociationByTwoPartKey(conn, canaryARN, groupName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Synthetics Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Synthetics Group Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("canary_arn", canaryARN)
+	d.Set("group_arn", group.Arn)
+	d.Set("group_id", group.Id)
+	d.Set("group_name", group.Name)
+
+	return nil
+}
+
+func resourceGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SyntheticsConn
+
+	canaryARN, groupName, err := GroupAssociationParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Synthetics Group Association: (%s)", d.Id())
+	_, err = conn.DisassociateResource(&synthetics.DisassociateResourceInput{
+		GroupIdentifier: aws.String(groupName),
+		ResourceArn:     aws.String(canaryARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Synthetics Group Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupAssociationResourceIDSeparator = ","
+
+func GroupAssociationCreateResourceID(canaryARN, groupName string) string {
+	parts := []string{canaryARN, groupName}
+	id := strings.Join(parts, groupAssociationResourceIDSeparator)
+
+	return id
+}
+
+func GroupAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupAssociationResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CANARY-ARN%[2]sGROUP-NAME", id, groupAssociationResourceIDSeparator)
+}
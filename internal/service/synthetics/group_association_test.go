@@ -0,0 +1,134 @@
+package synthetics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsynthetics "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSyntheticsGroupAssociation_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_group_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, synthetics.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "canary_arn", "aws_synthetics_canary.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_arn", "aws_synthetics_group.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "aws_synthetics_group.test", "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_name", "aws_synthetics_group.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsGroupAssociation_disappears(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_group_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, synthetics.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckGroupAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupAssociationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsynthetics.ResourceGroupAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_synthetics_group_association" {
+			continue
+		}
+
+		canaryARN, groupName, err := tfsynthetics.GroupAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfsynthetics.FindGroupAssociationByTwoPartKey(conn, canaryARN, groupName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Synthetics Group Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGroupAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Synthetics Group Association ID is set")
+		}
+
+		canaryARN, groupName, err := tfsynthetics.GroupAssociationParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+
+		_, err = tfsynthetics.FindGroupAssociationByTwoPartKey(conn, canaryARN, groupName)
+
+		return err
+	}
+}
+
+func testAccGroupAssociationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_basic(rName), fmt.Sprintf(`
+resource "aws_synthetics_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_synthetics_group_association" "test" {
+  group_name = aws_synthetics_group.test.name
+  canary_arn = aws_synthetics_canary.test.arn
+}
+`, rName))
+}
@@ -25,6 +25,11 @@ func init() {
 			"aws_cloudwatch_log_group",
 		},
 	})
+
+	resource.AddTestSweepers("aws_synthetics_group", &resource.Sweeper{
+		Name: "aws_synthetics_group",
+		F:    sweepGroups,
+	})
 }
 
 func sweepCanaries(region string) error {
@@ -70,3 +75,47 @@ func sweepCanaries(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).SyntheticsConn
+	input := &synthetics.ListGroupsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListGroups(input)
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Synthetics Group sweep for %s: %s", region, err)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error retrieving Synthetics Groups: %w", err)
+		}
+
+		for _, group := range output.Groups {
+			name := aws.StringValue(group.Name)
+			log.Printf("[INFO] Deleting Synthetics Group: %s", name)
+
+			r := ResourceGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				log.Printf("[ERROR] %s", err)
+				sweeperErrs = multierror.Append(sweeperErrs, err)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
@@ -0,0 +1,534 @@
+package timestreaminfluxdb
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/timestreaminfluxdb"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDBInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDBInstanceCreate,
+		ReadWithoutTimeout:   resourceDBInstanceRead,
+		UpdateWithoutTimeout: resourceDBInstanceUpdate,
+		DeleteWithoutTimeout: resourceDBInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(20, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(2, 64),
+					validation.StringMatch(regexp.MustCompile(`^[^_][^"]*$`), "must not begin with an underscore or contain double quotes"),
+				),
+			},
+			"db_instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DbInstanceType_Values(), false),
+			},
+			"db_parameter_group_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"db_storage_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DbStorageType_Values(), false),
+			},
+			"deployment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(timestreaminfluxdb.DeploymentType_Values(), false),
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"influx_auth_parameters_secret_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_delivery_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 40),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$`), "must start with a letter, contain only alphanumeric characters and hyphens, and not end with a hyphen or contain two consecutive hyphens"),
+				),
+			},
+			"organization": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 64),
+			},
+			"publicly_accessible": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secondary_availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"vpc_security_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &timestreaminfluxdb.CreateDbInstanceInput{
+		AllocatedStorage:    aws.Int64(int64(d.Get("allocated_storage").(int))),
+		DbInstanceType:      aws.String(d.Get("db_instance_type").(string)),
+		Name:                aws.String(name),
+		Password:            aws.String(d.Get("password").(string)),
+		VpcSecurityGroupIds: flex.ExpandStringSet(d.Get("vpc_security_group_ids").(*schema.Set)),
+		VpcSubnetIds:        flex.ExpandStringSet(d.Get("vpc_subnet_ids").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("bucket"); ok {
+		input.Bucket = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_parameter_group_identifier"); ok {
+		input.DbParameterGroupIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_storage_type"); ok {
+		input.DbStorageType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("deployment_type"); ok {
+		input.DeploymentType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("organization"); ok {
+		input.Organization = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("publicly_accessible"); ok {
+		input.PubliclyAccessible = aws.Bool(v.(bool))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		input.Username = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Creating Timestream for InfluxDB DB Instance: %s", name)
+	output, err := conn.CreateDbInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Timestream for InfluxDB DB Instance (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+
+	if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Timestream for InfluxDB DB Instance (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDBInstanceRead(ctx, d, meta)
+}
+
+func resourceDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instance, err := FindDBInstanceByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Timestream for InfluxDB DB Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(instance.Arn)
+	d.Set("allocated_storage", instance.AllocatedStorage)
+	d.Set("arn", arn)
+	d.Set("availability_zone", instance.AvailabilityZone)
+	d.Set("db_instance_type", instance.DbInstanceType)
+	d.Set("db_parameter_group_identifier", instance.DbParameterGroupIdentifier)
+	d.Set("db_storage_type", instance.DbStorageType)
+	d.Set("deployment_type", instance.DeploymentType)
+	d.Set("endpoint", instance.Endpoint)
+	d.Set("influx_auth_parameters_secret_arn", instance.InfluxAuthParametersSecretArn)
+	if instance.LogDeliveryConfiguration != nil {
+		if err := d.Set("log_delivery_configuration", []interface{}{flattenLogDeliveryConfiguration(instance.LogDeliveryConfiguration)}); err != nil {
+			return diag.Errorf("setting log_delivery_configuration: %s", err)
+		}
+	} else {
+		d.Set("log_delivery_configuration", nil)
+	}
+	d.Set("name", instance.Name)
+	d.Set("publicly_accessible", instance.PubliclyAccessible)
+	d.Set("secondary_availability_zone", instance.SecondaryAvailabilityZone)
+	d.Set("vpc_security_group_ids", aws.StringValueSlice(instance.VpcSecurityGroupIds))
+	d.Set("vpc_subnet_ids", aws.StringValueSlice(instance.VpcSubnetIds))
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.Errorf("listing tags for Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+
+	if d.HasChanges("db_parameter_group_identifier", "log_delivery_configuration") {
+		input := &timestreaminfluxdb.UpdateDbInstanceInput{
+			Identifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("db_parameter_group_identifier") {
+			input.DbParameterGroupIdentifier = aws.String(d.Get("db_parameter_group_identifier").(string))
+		}
+
+		if d.HasChange("log_delivery_configuration") {
+			if v, ok := d.GetOk("log_delivery_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			} else if o, _ := d.GetChange("log_delivery_configuration"); len(o.([]interface{})) > 0 && o.([]interface{})[0] != nil {
+				// Log delivery can't be removed, only disabled using the previously configured bucket.
+				input.LogDeliveryConfiguration = expandLogDeliveryConfiguration(o.([]interface{})[0].(map[string]interface{}))
+				input.LogDeliveryConfiguration.S3Configuration.Enabled = aws.Bool(false)
+			}
+		}
+
+		log.Printf("[INFO] Updating Timestream for InfluxDB DB Instance: %s", d.Id())
+		_, err := conn.UpdateDbInstanceWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDBInstanceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for Timestream for InfluxDB DB Instance (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating Timestream for InfluxDB DB Instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDBInstanceRead(ctx, d, meta)
+}
+
+func resourceDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).TimestreamInfluxDBConn
+
+	log.Printf("[INFO] Deleting Timestream for InfluxDB DB Instance: %s", d.Id())
+	_, err := conn.DeleteDbInstanceWithContext(ctx, &timestreaminfluxdb.DeleteDbInstanceInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, timestreaminfluxdb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Timestream for InfluxDB DB Instance (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("waiting for Timestream for InfluxDB DB Instance (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindDBInstanceByID(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	input := &timestreaminfluxdb.GetDbInstanceInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetDbInstanceWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, timestreaminfluxdb.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.Status); status == timestreaminfluxdb.StatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusDBInstance(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBInstanceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitDBInstanceAvailable(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			timestreaminfluxdb.StatusCreating,
+			timestreaminfluxdb.StatusModifying,
+			timestreaminfluxdb.StatusUpdating,
+		},
+		Target:     []string{timestreaminfluxdb.StatusAvailable},
+		Refresh:    statusDBInstance(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceDeleted(ctx context.Context, conn *timestreaminfluxdb.TimestreamInfluxDB, id string, timeout time.Duration) (*timestreaminfluxdb.GetDbInstanceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{timestreaminfluxdb.StatusDeleting},
+		Target:     []string{},
+		Refresh:    statusDBInstance(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*timestreaminfluxdb.GetDbInstanceOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func expandLogDeliveryConfiguration(tfMap map[string]interface{}) *timestreaminfluxdb.LogDeliveryConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.LogDeliveryConfiguration{}
+
+	if v, ok := tfMap["s3_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Configuration = expandS3Configuration(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandS3Configuration(tfMap map[string]interface{}) *timestreaminfluxdb.S3Configuration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &timestreaminfluxdb.S3Configuration{}
+
+	if v, ok := tfMap["bucket_name"].(string); ok && v != "" {
+		apiObject.BucketName = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogDeliveryConfiguration(apiObject *timestreaminfluxdb.LogDeliveryConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.S3Configuration; v != nil {
+		tfMap["s3_configuration"] = []interface{}{flattenS3Configuration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenS3Configuration(apiObject *timestreaminfluxdb.S3Configuration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BucketName; v != nil {
+		tfMap["bucket_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Enabled; v != nil {
+		tfMap["enabled"] = aws.BoolValue(v)
+	}
+
+	return tfMap
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -118,6 +119,44 @@ func ResourceTable() *schema.Resource {
 				},
 			},
 
+			"schema": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"composite_partition_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enforcement_in_record": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(timestreamwrite.PartitionKeyEnforcementLevel_Values(), false),
+									},
+
+									"name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 2048),
+									},
+
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(timestreamwrite.PartitionKeyType_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"table_name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -133,7 +172,10 @@ func ResourceTable() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTableCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -156,6 +198,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.MagneticStoreWriteProperties = expandMagneticStoreWriteProperties(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("schema"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Schema = expandSchema(v.([]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -217,6 +263,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.FromErr(fmt.Errorf("error setting magnetic_store_write_properties: %w", err))
 	}
 
+	if err := d.Set("schema", flattenSchema(table.Schema)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting schema: %w", err))
+	}
+
 	d.Set("table_name", table.TableName)
 
 	tags, err := ListTags(conn, arn)
@@ -262,6 +312,10 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.MagneticStoreWriteProperties = expandMagneticStoreWriteProperties(d.Get("magnetic_store_write_properties").([]interface{}))
 		}
 
+		if d.HasChange("schema") {
+			input.Schema = expandSchema(d.Get("schema").([]interface{}))
+		}
+
 		_, err = conn.UpdateTableWithContext(ctx, input)
 
 		if err != nil {
@@ -307,6 +361,30 @@ func resourceTableDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTableCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.GetOk("schema.0.composite_partition_key"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		name, _ := tfMap["name"].(string)
+
+		switch partitionKeyType := tfMap["type"].(string); partitionKeyType {
+		case timestreamwrite.PartitionKeyTypeDimension:
+			if name == "" {
+				return fmt.Errorf("`schema.0.composite_partition_key.0.name` must be specified when `type` is %q", partitionKeyType)
+			}
+		case timestreamwrite.PartitionKeyTypeMeasure:
+			if name != "" {
+				return fmt.Errorf("`schema.0.composite_partition_key.0.name` cannot be specified when `type` is %q", partitionKeyType)
+			}
+
+			if v, ok := tfMap["enforcement_in_record"].(string); ok && v != "" {
+				return fmt.Errorf("`schema.0.composite_partition_key.0.enforcement_in_record` cannot be specified when `type` is %q", partitionKeyType)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandRetentionProperties(l []interface{}) *timestreamwrite.RetentionProperties {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -458,6 +536,88 @@ func flattenS3Configuration(rp *timestreamwrite.S3Configuration) []interface{} {
 	return []interface{}{m}
 }
 
+func expandSchema(l []interface{}) *timestreamwrite.Schema {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	rp := &timestreamwrite.Schema{}
+
+	if v, ok := tfMap["composite_partition_key"].([]interface{}); ok && len(v) > 0 {
+		rp.CompositePartitionKey = expandPartitionKeys(v)
+	}
+
+	return rp
+}
+
+func flattenSchema(rp *timestreamwrite.Schema) []interface{} {
+	if rp == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"composite_partition_key": flattenPartitionKeys(rp.CompositePartitionKey),
+	}
+
+	return []interface{}{m}
+}
+
+func expandPartitionKeys(l []interface{}) []*timestreamwrite.PartitionKey {
+	var apiObjects []*timestreamwrite.PartitionKey
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rp := &timestreamwrite.PartitionKey{}
+
+		if v, ok := tfMap["enforcement_in_record"].(string); ok && v != "" {
+			rp.EnforcementInRecord = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			rp.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["type"].(string); ok && v != "" {
+			rp.Type = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, rp)
+	}
+
+	return apiObjects
+}
+
+func flattenPartitionKeys(apiObjects []*timestreamwrite.PartitionKey) []interface{} {
+	var l []interface{}
+
+	for _, rp := range apiObjects {
+		if rp == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"enforcement_in_record": aws.StringValue(rp.EnforcementInRecord),
+			"name":                  aws.StringValue(rp.Name),
+			"type":                  aws.StringValue(rp.Type),
+		}
+
+		l = append(l, m)
+	}
+
+	return l
+}
+
 func TableParseID(id string) (string, string, error) {
 	idParts := strings.SplitN(id, ":", 2)
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
@@ -3,6 +3,7 @@ package timestreamwrite_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -37,6 +38,9 @@ func TestAccTimestreamWriteTable_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.enable_magnetic_store_writes", "false"),
 					resource.TestCheckResourceAttr(resourceName, "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.type", "MEASURE"),
 					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 				),
@@ -245,6 +249,62 @@ func TestAccTimestreamWriteTable_retentionProperties(t *testing.T) {
 	})
 }
 
+func TestAccTimestreamWriteTable_schema(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamwrite_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, timestreamwrite.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_schema(rName, "OPTIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.enforcement_in_record", "OPTIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.name", "attr1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.type", "DIMENSION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_schema(rName, "REQUIRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.enforcement_in_record", "REQUIRED"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.name", "attr1"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.composite_partition_key.0.type", "DIMENSION"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamWriteTable_Schema_measureWithName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, timestreamwrite.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_schemaMeasureWithName(rName),
+				ExpectError: regexp.MustCompile("`schema.0.composite_partition_key.0.name` cannot be specified when `type` is \"MEASURE\""),
+			},
+		},
+	})
+}
+
 func TestAccTimestreamWriteTable_tags(t *testing.T) {
 	resourceName := "aws_timestreamwrite_table.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -513,3 +573,40 @@ resource "aws_timestreamwrite_table" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2))
 }
+
+func testAccTableConfig_schema(rName, enforcementInRecord string) string {
+	return acctest.ConfigCompose(
+		testAccTableBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  schema {
+    composite_partition_key {
+      enforcement_in_record = %[2]q
+      name                  = "attr1"
+      type                  = "DIMENSION"
+    }
+  }
+}
+`, rName, enforcementInRecord))
+}
+
+func testAccTableConfig_schemaMeasureWithName(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTableBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  schema {
+    composite_partition_key {
+      name = "attr1"
+      type = "MEASURE"
+    }
+  }
+}
+`, rName))
+}
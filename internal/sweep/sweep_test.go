@@ -21,11 +21,13 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/budgets"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/chime"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloud9"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -50,6 +52,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dlm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdb"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/docdbelastic"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -66,6 +69,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/emrcontainers"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
@@ -102,6 +106,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53profiles"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53recoverycontrolconfig"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/route53resolver"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
@@ -112,12 +117,14 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreaminfluxdb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
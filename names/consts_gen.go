@@ -10,7 +10,6 @@ const (
 	APIGatewayV2                 = "apigatewayv2"
 	AccessAnalyzer               = "accessanalyzer"
 	Account                      = "account"
-	AlexaForBusiness             = "alexaforbusiness"
 	Amplify                      = "amplify"
 	AmplifyBackend               = "amplifybackend"
 	AmplifyUIBuilder             = "amplifyuibuilder"
@@ -87,6 +86,7 @@ const (
 	DirectConnect                = "directconnect"
 	Discovery                    = "discovery"
 	DocDB                        = "docdb"
+	DocDBElastic                 = "docdbelastic"
 	DynamoDB                     = "dynamodb"
 	DynamoDBStreams              = "dynamodbstreams"
 	EBS                          = "ebs"
@@ -128,7 +128,6 @@ const (
 	GuardDuty                    = "guardduty"
 	Health                       = "health"
 	HealthLake                   = "healthlake"
-	Honeycode                    = "honeycode"
 	IAM                          = "iam"
 	IVS                          = "ivs"
 	IdentityStore                = "identitystore"
@@ -179,7 +178,6 @@ const (
 	MTurk                        = "mturk"
 	MWAA                         = "mwaa"
 	MachineLearning              = "machinelearning"
-	Macie                        = "macie"
 	Macie2                       = "macie2"
 	ManagedBlockchain            = "managedblockchain"
 	MarketplaceCatalog           = "marketplacecatalog"
@@ -200,7 +198,6 @@ const (
 	MigrationHubConfig           = "migrationhubconfig"
 	MigrationHubRefactorSpaces   = "migrationhubrefactorspaces"
 	MigrationHubStrategy         = "migrationhubstrategy"
-	Mobile                       = "mobile"
 	Neptune                      = "neptune"
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
@@ -238,6 +235,7 @@ const (
 	RoboMaker                    = "robomaker"
 	Route53                      = "route53"
 	Route53Domains               = "route53domains"
+	Route53Profiles              = "route53profiles"
 	Route53RecoveryCluster       = "route53recoverycluster"
 	Route53RecoveryControlConfig = "route53recoverycontrolconfig"
 	Route53RecoveryReadiness     = "route53recoveryreadiness"
@@ -282,6 +280,7 @@ const (
 	Support                      = "support"
 	Synthetics                   = "synthetics"
 	Textract                     = "textract"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
 	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
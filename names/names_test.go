@@ -25,6 +25,12 @@ func TestProviderPackageForAlias(t *testing.T) {
 			Expected: Transcribe,
 			Error:    false,
 		},
+		{
+			TestName: "rename",
+			Input:    "codeconnections",
+			Expected: CodeStarConnections,
+			Error:    false,
+		},
 		{
 			TestName: "primary",
 			Input:    "cognitoidp",